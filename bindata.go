@@ -1,7 +1,13 @@
 // Code generated by go-bindata.
 // sources:
+// tpl/array_template.java.twig
+// tpl/bridge_template.go.twig
+// tpl/bridge_template.java.twig
 // tpl/class_template.java.twig
+// tpl/grouping_template.java.twig
 // tpl/init_template.go.twig
+// tpl/partitioner_template.java.twig
+// tpl/struct_template.java.twig
 // DO NOT EDIT!
 
 // +build !debug
@@ -71,7 +77,67 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _tplClass_templateJavaTwig = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x56\xcd\x8e\xe2\x38\x10\xbe\xf3\x14\xbe\xb4\x08\x2b\xe4\x17\xa0\xb7\xb5\x52\x6b\xa5\xe5\xb0\xdd\xa3\x46\x9a\x39\x8e\x4c\xa8\x0e\x19\x82\x1d\x39\xe5\x34\x88\xe1\xdd\xa7\xec\xd8\x21\x09\x49\x60\x4e\xd3\xb9\x80\xed\xf2\x57\x9f\xeb\xe7\xb3\x73\x11\xef\x44\x02\xec\x74\x62\x3f\x44\x29\xbe\xf8\xe1\xf9\xbc\x98\x4c\xd2\x7d\xae\x34\x32\xa5\x13\x2e\xc8\x6e\x0b\x7c\x2b\x36\x4a\xe5\x3c\x55\xfc\xaf\xc5\xf0\xf2\x5e\xe4\x1a\x36\x26\x06\x4e\xa8\xd5\xe0\x39\x13\x45\xf1\x22\xf6\x6d\x64\xeb\xd1\x82\x2d\x5f\xff\x3d\xc4\x90\x63\xaa\x24\x2d\xe6\x66\x9d\xa5\x31\x8b\xed\x96\xc0\xab\xb9\x7f\xc2\xfc\x07\x07\x04\xb9\x71\x36\xd7\x5e\x1e\x69\x76\x07\xc7\xa5\xfc\x59\xb1\xfa\x8e\xc7\xdc\xce\xcf\xad\x79\x29\x32\x03\xfd\x4b\xb4\xe7\xd5\xe0\xe0\xa6\xeb\xb5\x27\x76\x9a\x38\x4a\xb9\x4e\x4b\x81\xe0\x89\x3f\x2b\x23\x11\x74\x93\x64\xa2\xd6\xa9\xac\x48\xbe\x29\x85\xb4\x97\x7b\x2b\xbe\x42\xb3\x26\x9c\x70\xb2\x00\x35\x16\xdb\xe0\x20\x46\xbd\x98\x5c\xed\xf4\xab\xd1\x9d\x08\xb3\x86\x73\xfb\xe1\x36\x2d\x38\xcd\xb3\xbf\x2b\xfc\x30\x7f\x6e\x78\xaa\xd2\x94\x29\x99\xb0\xaf\x36\x34\x51\x17\x44\x03\x1a\x2d\x6b\x2c\x9e\x00\x7a\xc3\x31\xc0\x52\xa5\x1b\xb6\x0a\xa6\x0e\x5e\xec\x71\x88\x20\x2f\x82\xa5\x35\xba\x89\xbb\x94\xb1\x86\x3d\x48\xbc\x0d\x9c\xd6\xa6\x5d\xe4\x06\x7e\x37\xe7\x14\xcf\x03\xf6\xe4\x1c\x0f\x2e\xed\x36\xe5\x03\xa9\xfe\xc3\x45\xcc\x03\xf7\x18\x0f\xbd\xe5\xe4\x56\xa3\x4f\xc4\x72\x20\x71\x07\x57\xb2\x87\x9b\x95\xf0\x4d\xa7\x08\xd1\x85\x85\x55\x99\xe0\x87\xed\xda\x2c\x5a\x6b\xe5\x95\x63\x7d\xec\xcc\xd8\x8f\x38\xf0\x0f\xe7\x43\xc2\xc7\xe0\x69\xa3\xdd\x6c\xce\xbc\xc1\xc0\xa1\xa3\x72\xd6\xa8\x3e\x77\x22\x16\x0b\x8c\xb7\x2c\xaa\x55\x93\x41\x97\x94\xfd\x56\xc7\x02\x61\xcf\x95\x41\x4e\x79\x94\x98\xc9\x08\xba\x50\x23\x61\x1a\x55\xac\x5a\x60\x56\x48\xd0\x09\x4b\xb4\x32\xf9\x9c\xf9\x91\xa4\xc2\x18\x50\x03\x7b\xd8\xb0\xd7\xc6\x88\x44\x21\x0c\x3d\x86\xdb\x3c\xda\xca\xde\xcb\x0a\x05\x9a\x62\x48\x76\x3c\x78\x30\xba\x47\x72\xbc\xad\x87\x2f\xdc\xa8\x8b\x6e\x61\x8b\xda\xd4\xdb\xf4\x82\x9f\x1e\x58\xfa\xce\x50\x68\x62\xc1\x97\xc5\x9b\x13\x5d\x4d\x74\x1f\xce\x57\xfd\xe5\x6e\x42\x83\x69\xc6\x97\x14\x09\x81\x4a\x3f\x0e\xf6\xce\x13\xa3\xaa\x6a\x69\x7e\xfb\x14\x16\x21\x72\x80\x99\x90\x49\x05\xb8\xce\xe0\x16\x60\x6f\x3f\xd9\x05\x6a\x28\xfb\xe3\xfe\x5b\x66\xe3\xb1\x5c\x2b\x95\x81\x90\xec\x3f\x51\xbc\x58\xc5\x18\xbb\x14\x1c\xec\x36\x18\x8e\xa1\x36\xb8\xb7\x9a\xf1\x3e\x17\xd2\x59\xd9\x6a\x88\xae\x84\xdc\x67\x8a\xf4\x9a\x92\xf5\xd0\x2b\xee\xed\x46\xb0\x6e\xe9\xfd\x92\x2d\x5a\x36\xd7\xf2\x79\x61\xde\x7d\xc0\xb4\x08\x17\x26\x87\x56\x48\x2d\x36\x85\xfc\xe2\x54\xc9\x02\xb5\x89\x29\xf2\x6e\xef\xa2\x49\xf1\x9f\xd7\x12\xb4\x4e\x37\xe0\xc9\x28\x84\x18\x61\x53\xd5\x02\x95\xa9\xc9\x3f\x8f\x64\x57\xbf\x33\xca\x8b\x56\x1f\x05\x6b\x3c\xfa\xe6\x74\x2f\x53\x9e\xb4\xc9\x89\xfb\x45\xd4\x2e\x41\xaa\x64\xbd\x92\x8e\xea\x22\x0a\x68\x63\xd1\x68\xb4\x45\x1d\x84\xff\x01\xb7\x6a\x13\x12\x31\x10\x05\x3b\x39\x1f\xeb\xdf\xbe\x9e\x72\xbf\xa9\xac\x0f\x6e\x8b\x2a\x2b\x80\xac\x87\x8d\x1a\x85\x57\x99\xcc\x3f\xcf\x3b\xc0\x07\xb8\xa3\x09\xbf\x9d\xba\x9a\x39\x6a\x21\x8b\x77\xa5\xf7\xd1\x94\x26\xa6\x73\x36\xdd\x4d\x67\xcd\xe7\xfc\x3d\x7a\x69\xd5\x37\x48\x9c\x3b\x42\xa7\x71\x78\xdd\x36\xed\x44\xd3\x85\x6e\x9f\x0c\x8b\x56\xcb\x57\xd9\x69\x32\x0d\xa1\x6c\x70\x75\x53\x96\x6d\xd9\x66\x7b\xc3\x5b\xd9\xe7\xb0\xad\x31\xe7\x5f\x01\x00\x00\xff\xff\x1e\xe6\xc9\xa3\x7c\x0d\x00\x00")
+var _tplArray_templateJavaTwig = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x56\xcd\x6e\xe3\x36\x10\xbe\xeb\x29\xa6\x97\x84\x72\x04\x25\x12\x7a\xf3\xba\xe8\x22\x28\x8a\x00\x45\x53\xa4\x05\x7a\x30\x8c\x82\x92\xc6\x16\xb7\x34\xa9\x92\xf4\x3a\x6e\xaa\x77\x2f\x48\x53\x36\x6d\xd3\x3f\x87\xe5\xc1\xe2\xcf\xcc\x7c\xc3\xe1\xcc\xe7\xe9\x68\xfd\x37\x5d\x20\x7c\x7c\xc0\x17\xfa\x95\xfe\xe6\x97\x7d\x3f\x4e\x12\xb6\xec\xa4\x32\x20\xd5\x22\xa7\x1d\xad\x5b\xcc\x5b\xda\x48\xd9\xe5\x4c\xe6\xa3\xfd\xb9\xd5\xb3\x5b\x2f\xaf\x3f\xbd\xd7\xd8\x19\x26\xc5\x38\x49\x1e\x47\xa3\x04\x46\x83\xdd\x67\x4e\xb5\xfe\x95\x2e\xad\x65\x68\x25\x6f\x34\x98\x16\xe1\x67\x09\x66\xd3\x39\x74\xfb\xcd\xad\x04\x49\xa1\xef\xf3\x04\x46\x8f\x49\xb7\xaa\x38\xab\xa1\xb6\xca\x51\x4b\xf8\x6e\x50\x34\x1a\x3e\x2b\x45\x37\x7f\x2a\x66\x68\xc5\x11\xd8\xb2\xe3\xb8\x44\x61\x34\x0c\x7b\xcf\x72\xd9\x51\x65\x67\x9f\x22\x76\x7e\x80\x8f\x04\x00\x40\x1b\x6a\x58\xed\x17\x76\x1c\xa9\x1b\xa9\xf2\x06\xe7\x4c\x20\x89\x58\xc9\x9d\x9f\x19\x08\x5c\xc3\x5e\x81\xa4\xe9\xd8\xd9\xeb\x13\xf7\xf1\x77\x8a\xe8\x93\x34\x40\xd6\xab\x0e\x95\x45\x41\x8e\xcb\xff\xb6\x71\xff\xcb\xc5\x6a\x00\x4a\xc7\x7b\x61\x34\xc4\xa2\x0e\xee\x4e\x9f\x66\x87\xa0\x3f\xbe\x7e\x45\xa5\x58\x83\xa1\x0b\x4c\x18\xa8\x9d\x9f\xf8\x87\x8c\x5d\x08\x64\x1a\x89\xc5\x74\x06\x14\x26\xb0\x40\x43\xd2\x71\xec\xb4\x82\x09\xc8\xfc\xe8\x7c\x2e\x15\x10\x8b\xc8\x60\x02\x4f\x63\x60\xf0\x09\x68\xce\x51\x2c\x4c\x0b\x77\x77\x6e\x5d\xf9\xf5\x18\xd8\xc3\x43\x08\x6d\x87\xf3\x16\x26\x40\xce\x04\x25\x05\x3a\x65\xb3\x34\xdf\xdf\xe8\xac\x60\x65\x05\xc7\x87\xd6\xe7\x40\x6a\xf8\x6e\x02\x4f\xc7\xb8\x76\x28\x34\x2b\x25\xa0\x3e\xd4\xe9\x93\xd3\x99\x97\x7c\x11\x06\x17\xa8\x06\x67\xc8\x70\xd3\x6c\x77\xc7\x1b\xde\xa7\x92\x92\x23\x15\x80\xff\xac\x28\xd7\xe4\xb5\xfa\x82\xb5\x39\x7c\x13\x0f\x27\x81\x09\x6d\xa8\xa8\x51\xce\xa3\x75\x72\x77\xb7\xad\xd2\x95\x61\x3c\x77\xc5\xa2\x73\x6f\xd6\xbd\x53\xb6\x0d\xeb\xb1\x5e\x0a\x32\xdd\x3e\xe4\x8d\xe9\xd4\x52\xdd\x3e\xcb\x06\x49\xc4\xc9\x13\x07\x76\xc2\xb7\x22\xfc\x6e\x14\x13\x0b\x30\x72\x3b\xb9\x09\x64\x27\x1c\x01\x71\x14\x65\xc7\xc8\xd7\x2b\x6a\xd0\xa8\x18\xe5\xec\x5f\x6c\x62\x81\xd4\xa0\x0d\x55\xc6\x7a\x41\x0d\x54\xc5\x54\x17\x33\xa0\xa2\x81\xaa\x9c\xea\x72\x36\x58\xab\xa5\xd0\x4c\x1b\x14\x86\x6f\x60\xcd\x4c\xbb\xaf\xb3\xdc\xcb\x3c\x86\x17\xf3\xbc\x13\x14\xe4\x1b\x5d\x93\x6a\x63\x5c\x39\x15\x99\x3b\xd1\x45\x06\xc3\x56\xe9\xb7\xca\x14\x4c\xab\xe4\x5a\x43\x40\xbe\x41\x54\xac\x90\x28\x60\x12\x23\x32\x85\xb4\x79\x11\x86\x58\xfb\xba\x48\xc7\x87\x4a\xe5\x15\xa5\x32\xb3\xe8\x7b\x25\x5d\xc0\xc3\x04\xbe\x0f\x36\xca\xa3\x8d\x18\x03\x88\x62\xa8\x7d\x51\x5e\xac\x7a\x5f\xca\xf9\x1b\x5d\xfb\xa7\x22\xf7\x55\x71\x9f\xc1\xbd\x76\xbf\x55\xe9\xe6\xe5\x7d\xea\xfe\xba\xbe\x5d\x69\xef\xef\x16\xb8\xf0\x8b\x2b\xe1\xc0\x83\x53\x54\x5d\x9e\xd3\x89\x7b\x7a\x95\x42\x44\x91\x81\x28\xcf\x26\xf0\x9b\xd3\xda\xfe\xab\x7a\x56\x95\x73\xb7\xba\x9c\xd1\x87\x09\x3d\xd5\xb3\x6b\x09\xba\x35\x1e\xe6\xa7\xcf\xc5\x5b\x52\xf1\x4a\x52\x65\xa0\x8f\xf2\x90\xc3\xf5\x1c\x8a\x26\x0e\x3f\x17\x7e\x17\x7d\x78\x00\x7e\xed\x01\xf8\x65\xae\xb0\x9e\x43\x7d\x2b\x6d\x58\x12\x90\x2b\x33\x98\x68\x70\x50\x70\x84\xd6\xe2\xf2\x52\xd8\xb7\xfd\x4f\x00\x3b\xb4\x3d\xa7\xb1\x0c\xa2\xe0\x6d\x84\x9d\xc8\x51\x8c\x76\x3d\x46\xbc\x93\x49\xc3\xe0\xec\xa6\x87\xcc\x1c\x6f\x27\x62\xd4\x65\xbf\x3c\x46\x61\xfe\xa8\x3c\xf6\xce\xa8\xcd\x85\x7a\x0d\x68\xd2\xf1\x57\x06\x27\x94\x64\x47\x0f\x35\x35\x75\x0b\x24\x4c\x4a\x8c\x11\x81\x4b\x5e\xd7\xbb\xbd\x70\x8e\x0b\xca\x3f\xab\xc5\xca\x36\x91\x3b\x3d\x82\xe9\xe5\x06\xa0\x4f\xfa\xe4\xff\x01\x00\x37\xae\xd8\xf9\x50\x0b\x00\x00")
+
+func tplArray_templateJavaTwigBytes() ([]byte, error) {
+	return bindataRead(
+		_tplArray_templateJavaTwig,
+		"tpl/array_template.java.twig",
+	)
+}
+
+func tplArray_templateJavaTwig() (*asset, error) {
+	bytes, err := tplArray_templateJavaTwigBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tpl/array_template.java.twig", size: 2896, mode: os.FileMode(420), modTime: time.Unix(1792301220, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tplBridge_templateGoTwig = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x5a\x5b\x73\xdb\xba\x11\x7e\x26\x7f\xc5\x1e\xcf\x24\x26\x3d\x2a\xdd\xf6\xd1\x1d\x3d\xe4\x38\x97\xba\xa7\x71\x32\x76\xa6\xe7\xa1\xd3\xc9\x40\xe4\x4a\xc2\x98\x02\x39\x00\x28\x5b\x61\xd4\xdf\xde\x59\x00\xbc\x93\xb2\xe2\x38\x6d\xfa\x90\x89\x05\x2e\x16\xdf\x5e\xb0\xf8\x16\xe4\xf9\x39\x5c\x66\x09\xc2\x0a\x05\x4a\xa6\x31\x81\xc5\x0e\x56\xd9\x1f\x36\x52\x30\xcd\xb7\x18\xc1\xeb\x0f\x70\xfd\xe1\x13\xbc\x79\x7d\xf5\x29\xf2\xfd\x9c\xc5\x77\x6c\x85\x50\x96\x20\xd8\x06\x61\xbf\xf7\x7d\xbe\xc9\x33\xa9\x21\xf0\xcb\x17\xb0\xcc\x24\xe4\x4c\xaf\x81\x0b\xb0\xe3\x0a\x5e\xec\x7d\xef\xa4\x2c\xed\xf8\x7e\x7f\x42\x72\x28\x12\x12\x7d\xb1\xf7\x43\xbf\x9a\xa7\x69\x92\x66\x72\x85\x76\x52\xf9\x02\xf8\x12\x74\x64\x87\xa2\x2b\xf5\x91\x49\xcd\x35\xcf\x04\xca\x20\x84\x4c\xb6\x9f\xbd\x93\x59\x91\x73\xb1\x0a\x42\x9a\x7a\x7e\x4e\x08\x75\xb4\xca\x7e\x95\x3c\x59\x11\x4e\x60\x09\xcb\xb5\xaa\xc6\x6f\xb5\x2c\x62\x7d\x6d\x6d\x30\xcb\x17\x0a\xc9\x78\xbd\x6e\x7b\xe3\x6f\x6c\xcb\xec\x94\x0d\xcb\x25\x26\x97\x29\x53\xca\xcd\x8a\x7c\xbd\xcb\x71\xb8\x90\x32\xaa\xa1\xf4\x3d\xbe\xc9\xd3\xea\xf9\xa5\xce\xe4\x27\x92\xdf\xef\xfd\xbd\x4f\x08\xaf\xf1\x7e\x30\x37\x96\xc8\x34\x2a\x60\x20\xf0\x7e\xa0\x7a\x06\x12\x59\xb2\xab\xe0\x46\xfe\xb2\x10\xf1\x98\x9e\x20\x84\xb3\x81\xee\xd2\xf7\x24\xea\x42\x0a\x78\xd9\x7f\x56\x12\xd2\x8b\x96\xa6\x8e\x7b\x82\x70\xef\x8f\x84\xa3\xeb\x72\xdf\xa4\xd2\x26\x67\x12\x21\x66\x69\x3a\xee\xe9\xa8\x12\xb9\xe7\x7a\x0d\x77\xb8\x53\x10\x67\x62\x8b\x92\x9c\xbd\x94\xd9\x86\xfc\xcf\xa5\xf5\xbb\xc4\x5c\xa2\x42\xa1\x19\x05\x3d\xa2\x15\x5e\x41\xce\x04\x8f\x81\x2b\x90\x18\x67\x5b\x94\x98\x00\x13\x09\x58\xcb\xe8\x07\x39\xef\x6c\x61\x2c\x8b\x3e\x92\xf0\x1b\x29\x33\xe9\x7c\x15\x2c\x86\x8e\x09\x2b\xdc\x81\x79\x72\x87\xbb\x2b\xf1\x75\xc1\x45\xf2\x39\x67\x92\x6d\x54\x70\x7a\xf7\xa7\xd3\xd0\xb8\x7f\x52\xe0\xcf\x46\x20\x84\x20\x06\x2e\xf4\x0c\x50\x4a\xfa\x97\xc9\x90\xdc\x9e\xe0\x12\x25\x38\x4c\x37\x16\x77\xf0\x12\xa5\x0c\xeb\x90\x2c\x22\x0a\x41\x34\x82\x44\x67\x9f\x57\xd9\x41\x28\x3d\x09\x87\x65\x06\x82\xa7\x36\x6e\x98\x2a\xac\x62\x54\x6f\xa2\x43\x51\x6a\x84\x4c\x9c\xb6\x2c\x2d\xf0\xa7\x88\x54\x0d\x6c\x3a\x56\xb8\x6b\x7b\xc8\x40\xef\xcb\x6c\x59\xea\x64\x44\xb1\xa9\x55\x2a\x0a\x5d\x08\x41\xfe\x7d\x21\x1c\x85\xd8\x0b\xd1\x38\xc6\xae\xd0\x04\xc8\x4e\x5c\x45\xc2\x97\xf0\xa2\x13\xe3\x9f\xa9\xf4\x8d\xd5\x3e\x2f\x2b\x34\x40\xe5\xc9\x5f\x8b\x25\xf9\xd5\xe4\x25\x97\x0a\xee\x25\xd7\x1a\x05\xa1\x21\x0d\x33\x10\x99\x86\x1d\x6a\xc8\x99\x52\x98\x80\xce\x0c\xae\xc8\xf7\x34\x53\x77\xb5\x9a\x4f\x4c\xdd\xf9\xde\xaa\x60\x32\xa9\x86\xde\x99\x1f\xe7\xe7\x40\xc1\x92\x89\x82\xfb\x35\xea\x35\x4a\x63\x65\xca\x94\x36\x69\x29\x13\x9b\xa6\x77\x98\x44\xff\x9f\x65\xd9\xee\x69\x6b\x82\xdb\x5b\x0a\xb4\x2c\x90\xaa\x75\x2e\xb3\x18\x95\xe2\x62\x35\x69\xf6\x0c\x24\x23\xbf\x90\x1e\xbd\x66\xc2\xe9\xa0\x29\x4c\xd8\x0d\x10\xc1\x07\x91\xee\x40\x15\xf1\xda\xcd\x56\xb0\x61\x3b\x58\x20\xa8\x3b\x9e\xe7\x76\x2f\x2f\x58\x52\x3d\x7d\x64\x0b\xdb\x85\x83\x10\x16\x59\x96\xb6\xec\x5f\x44\x26\x82\x51\x23\xe1\xec\xbb\x45\xfd\xbb\xe4\x1a\x6d\xb6\xdc\xf2\x2f\x08\x0a\xb5\x32\x36\x89\x62\xb3\x40\x09\xd9\x12\x16\x3b\x0a\x51\xb6\x84\xac\xd0\x79\xa1\x21\xce\xd2\x14\x63\xc3\x69\x70\x99\x49\x24\x4d\x94\x46\x64\x1a\xd7\x75\x2a\xc1\xd5\x12\x14\xa9\xe4\x0a\xbe\xa0\xcc\x66\x80\x2c\x5e\x43\xce\xb8\xa4\xa1\x6e\xe2\x91\xa1\x5c\x93\x26\x5e\x27\xeb\x41\x6b\x87\xd0\x03\xbb\x18\x95\x9a\xd2\xf7\x16\x51\x56\xe8\x88\xc6\x61\x6e\x60\x34\x26\x53\x52\xd7\xfe\x26\x53\x4d\xca\x33\xad\x71\x93\x6b\x53\x4a\xb9\x20\x3b\x55\x9e\x72\x3d\xbe\xb9\xb9\x6a\xa5\xc0\x63\x38\x69\xbd\xc0\xa9\xbf\x7a\x3d\xb3\x7c\x4d\x69\xc9\xc5\x6a\x06\x4a\x33\xa9\x67\x90\xa2\x58\xe9\x75\x0b\xbd\xc1\x34\x6f\xef\xc3\xf2\x55\xa5\xe2\x02\x5a\xda\x3e\x32\xbd\xbe\x30\x3a\x67\x70\x4b\xca\x2e\x2a\x9d\x7f\x37\x3a\x2f\x9c\xee\x7d\xe3\x80\x4b\x16\xaf\xb1\xe3\x81\x34\x8b\x59\x6a\x94\x98\x40\xd3\x50\xc2\x09\xe2\xa2\xa0\x38\xc7\x66\xc2\x92\xa7\xb4\x55\x45\x42\x6a\x98\x8c\xd7\x7c\x8b\xca\x45\x15\x45\x9c\x25\xee\xec\x11\xf0\x4a\x4a\xb6\xa3\xf0\xb0\x45\x8a\xa4\xf0\x13\x3e\xe8\xc7\xdc\x64\x50\x05\x66\x91\x59\xad\x1e\xfe\xf9\x2f\x4a\xbf\x96\x53\x22\x23\xf7\xd6\x60\xa9\xfd\xf3\x1a\x69\xf9\xdb\x94\xc7\x18\xb8\xa1\x6b\xbc\xb7\xa3\xd2\xea\x0c\x67\x10\x9c\x75\xc4\x65\x18\x11\xae\xb0\xab\xf9\x55\xb5\xf2\x91\xca\x2b\xa4\x07\xf4\x5b\xc7\xc7\x99\xd0\xf8\xa0\x9b\x5a\xb2\xc6\xa6\x8e\x3f\x5c\x09\x8d\x72\xc9\xe2\xfa\xec\x60\x86\x47\x58\x46\x60\xf7\xd3\x07\xbb\xfd\x52\x5c\x6a\xaa\xe3\x4c\x90\x56\x64\x32\xe5\x28\xad\xb0\x5e\x33\x5d\x17\x20\x4a\xd2\x84\xab\x98\xc9\x04\x93\x83\xce\x77\xc8\x82\x58\x3f\x74\x6b\xf0\xa5\x7e\x30\x02\xfd\xc1\x2b\x3a\x84\xf6\xfb\x66\x9f\xdd\xa0\x42\x1d\x84\xf5\xb6\x43\x91\xc0\x1c\x62\xfd\x10\xd9\x4d\xca\x74\xbc\xae\xcb\xd1\x84\xb6\x32\xd6\x0f\x33\x78\x69\x34\x98\xff\x29\x26\x55\xd6\x8e\xc0\x22\xfb\xa8\x8e\xe3\x06\x85\x6b\xb0\xbe\xef\xa0\xad\xd4\xd6\x91\x28\xab\x0e\x6a\x63\x3a\xa8\x8a\xa1\x5f\x3a\x77\x85\xd1\x7b\xd4\xeb\x2c\x51\x96\xa8\x5b\x1e\xbf\x89\x68\x85\x20\x84\xf9\x1c\x4e\x8c\xf1\x27\xf4\xd0\x33\x7f\x06\x77\xb8\xab\x09\xe6\x87\x42\x5b\xf6\xa4\xed\x09\x3e\x23\x3a\xd8\x22\x2e\xfd\xe7\x61\xaf\x51\x30\x1a\x6f\x6c\x32\x19\x82\x67\x60\xd8\x93\xa5\xcd\x61\xfa\x84\xa6\x0f\xf2\x1a\x1f\xb4\xc5\x78\x6d\xac\x1a\xe1\x77\x0e\xc1\x63\x9a\x0c\x22\xfa\x9d\xb4\x6c\x36\xbf\x03\xd3\xd4\x56\x35\xaf\xf6\x02\x8d\x26\xbf\xe1\x6e\xca\x0f\xe6\xf9\x3f\x08\xc9\xe3\x9e\x30\xeb\x3c\x83\x3b\xde\xa1\xbe\x35\x38\x95\x35\xa2\xf9\x6d\xa2\x67\x6d\x08\x5d\x5d\x3a\xa4\xec\x97\x26\x01\xe8\x4c\x19\x8e\x3b\x4f\x0d\x1e\xda\x80\x0c\x86\xdb\xc0\xe8\xa1\xc8\x74\xbb\x6b\xb4\x39\x6c\x33\x32\xa8\x26\x9a\xc4\xf4\xca\x12\x36\xd1\x2d\x5f\x09\xa6\x0b\x49\xea\x86\xa1\x6c\xee\x0d\xbc\x66\xc7\x06\x8b\xba\xfc\x1a\x37\xf6\x5b\xd5\x9b\x42\xb8\xdc\x37\xa9\x63\xe7\x84\x10\xd8\x49\x33\x3b\x29\xec\x2c\x35\xbe\x9b\xab\x7a\xe2\xf8\x34\x1b\xdd\x97\x3a\x9b\x28\x97\x13\x7b\xb9\x52\xda\x70\xe7\x11\xad\x8e\x36\x9f\x75\x68\xb3\x23\xc1\x67\x6d\x12\xbc\xf7\xfb\xd6\x8f\xef\x3f\x32\xcf\x3c\xa1\xf2\x24\x70\x8b\x12\x16\x46\x29\x31\x42\x95\xb9\xd3\x92\xc4\x6d\x07\xe7\x7a\x36\x57\xbc\xea\x2a\x6e\x0e\x0c\x56\x10\x43\xe2\xf5\xc9\x19\x4f\xd9\x18\xc2\x68\x79\xa9\xb2\x67\xb4\xb6\x34\x0f\x5d\x74\x5b\x84\x31\x8e\x46\x5c\x65\x0d\x0e\xda\xe5\x8b\x0e\xa7\xcf\xab\x6c\xbc\xf1\xea\x08\x54\x4d\x57\xd8\x6d\x9c\x7f\xb0\x5d\xd4\x2f\x2d\xe1\x97\xd8\x1c\x4a\x6f\x04\xb1\x11\xa2\xc7\xa5\xef\x79\x3f\xd2\x44\xcf\xf9\xd1\xf7\xf6\xbe\x87\x70\x31\x87\x97\x15\x04\xc3\x0b\x7c\xaf\xb5\x82\x1b\x0c\x4e\xf1\x74\x06\xf5\x2a\xbe\xd7\x59\xa5\x2b\x54\xad\xe4\x7b\x56\xed\xef\x96\x2f\x07\x21\xb5\x65\x6f\x19\x4f\x31\x31\x1c\x9a\xd8\x9a\xac\x88\x9e\x4d\x32\xdb\xe4\x8d\x55\xf2\x56\x29\xb9\xc1\xa4\x88\xd1\xe5\xf3\xe3\x31\x1a\x39\x36\x9a\x20\x90\xb3\x05\xd1\x9e\x8b\xf9\x44\x5a\xd9\xe9\x5d\x76\xd0\xe9\xdc\x83\x53\x52\x60\x2d\x3e\x88\xdc\x44\x4f\xd9\x03\x27\xac\x6a\xfd\x91\x07\xc5\x91\xa9\x78\xcc\x69\x36\x9a\x9e\xcd\x51\xf6\xc4\x8d\xd7\xac\x3c\xeb\x1d\x9e\x53\x29\xda\x3a\x3d\x9f\x67\x1f\x3e\xb3\xf1\xa5\xef\xfd\xd7\xed\xed\x1e\x79\xa3\x59\xd4\xd0\xbb\xbf\x32\xe5\xce\xd3\x93\x2b\xea\x08\xa9\xd5\x3a\x39\x72\x53\xd4\x13\x82\xd0\x39\xab\x13\x66\xd3\x6f\xd0\x63\xff\x89\x58\x4c\xab\xf7\x4d\x60\xcc\x8c\x20\x04\x2e\xf4\x10\x8a\x79\xf8\x54\x2c\xb6\xd1\xfc\x26\x30\x76\xca\x14\x1a\xfb\xf4\x09\x70\xe8\xa8\xae\xdb\xe4\x63\x01\x75\x26\x1d\x08\x57\x2d\xf3\x04\x60\x4d\xd3\x7a\x2c\xaa\x66\x46\x40\x6c\x73\x0a\x54\x23\xf6\x54\x54\x55\xc3\xfb\x4d\xc0\xaa\x49\x8f\x63\xab\x24\x9f\x00\xaf\xc5\x78\x8f\xc4\x36\x49\xd6\x1b\x88\x09\x9d\x44\xc3\x36\x7e\xbc\x12\x75\xf5\x85\xad\x3b\xe9\xe1\xd5\x40\x72\xe8\x02\xa0\x67\x3a\x71\xed\xb5\x6d\x2a\xd7\x59\x76\xa7\xaa\x97\x08\x65\x09\xeb\xd6\xd9\x79\xe0\x4d\x42\x57\xf2\x07\xbe\x19\xe8\x2e\x34\x7d\x47\x10\x1c\x7d\xaf\x3f\xdd\x45\xc4\x86\x2b\x75\xd4\xdf\x14\xa2\xba\x1f\x98\x0a\xfb\x05\x2c\xa2\xd6\x15\x46\x08\x9d\xa3\xcd\x2a\xed\x4a\x8c\x64\xe2\x3a\x1a\x52\x02\x22\x8f\x64\x95\x99\x6f\xde\x41\xf4\x9d\x11\xfe\xc5\x08\xfc\x32\xa7\x77\x07\x86\x57\xba\xfc\x40\x29\x89\xfd\xb5\x71\x4c\xa9\xe8\x80\x69\xae\x6c\x89\xd8\xbd\x4d\x0b\xb5\x0e\x9a\xfc\x71\x2d\xda\xa4\x03\x07\xbd\x55\xe3\xbd\x27\xf6\x56\xf6\x0a\x9e\x8b\x15\xa9\xae\x6e\x0c\xeb\x3b\x29\xca\xe0\x05\x75\x7d\xa8\xc6\x9a\xb0\xd6\xea\xd3\x4d\x98\x93\xf0\x3d\xa3\x08\x7a\x3b\xc8\xf7\x68\x1f\x43\xeb\xad\x5c\xe3\x3b\xdf\x23\x82\x01\x13\xc4\xd3\xf7\x28\x32\xe0\x3a\xd7\x56\xf1\x38\x9b\xc0\x18\xc2\x6d\xcc\x44\xeb\xf6\x9c\x2f\x21\x8e\xc6\xc3\xbb\x64\xa9\x42\x43\xef\x8d\x90\x85\x3e\xb7\x62\x5f\xbf\x56\x23\x74\x8e\xd9\x5b\x85\x3f\x9a\xc9\x8b\x59\x95\x4e\xd3\x4c\xd8\x35\xd1\xbe\x57\x25\x5f\x6b\x71\xcf\xc2\x99\xdb\xec\xea\x83\xf1\xf6\x76\x52\x8a\x22\x58\xb4\x56\x1d\x13\xab\x21\x8f\x54\xc2\x45\x68\x0c\x8b\xc9\xdd\x30\x6f\x7b\xde\x4a\x04\xa7\x6e\x76\xdd\x83\x50\x18\xe6\xdd\x30\x8c\x8b\x3a\x24\x5a\x16\x78\x5c\x44\x7e\xc3\x5d\x10\xb6\x21\x74\xfa\x8a\xfa\xb4\xb9\xc3\xdd\x71\xfa\x0c\x3b\x3c\xd8\xab\xd4\x3a\xb7\x2c\x3d\x4e\xe7\x1b\x49\xe5\x62\xc8\xe4\x29\x48\x76\x4b\xde\x14\x07\x5f\x0a\xdf\x14\x62\x56\xbf\x40\xa9\xf6\x18\x6d\xbb\x89\x8d\xf6\xc3\x4a\xfd\x4d\x21\x9e\xa3\xbe\x3f\x6b\x19\xef\x14\xba\x23\x2a\xb4\xb1\xe1\x09\x65\xd9\xce\xeb\xd6\xe2\xb6\xf2\x56\x39\x7e\x44\x79\x3f\x03\xdc\x62\xdd\x16\xfb\x3d\xcb\xf3\xba\xc3\x3e\x3f\x87\xf7\x2c\x3f\x94\x22\xf4\xf8\x27\xfb\x62\xe0\x3d\xcb\x9f\xe9\x5b\x81\x6f\x4c\x39\xf7\x32\xf3\x30\xa7\x38\x22\x53\x7a\x06\x7c\xd7\x97\x04\xdd\xcc\x7d\x42\xfa\xfd\x40\x30\x47\xf3\x8b\xd6\xc7\x2c\xf6\x06\xe8\x60\xd1\xb2\x12\x3f\x59\x52\x5a\x54\x47\xe4\xe5\xff\x28\xe7\x86\xf8\xa6\x22\xfd\xdd\x19\xf5\xe4\xa5\xbe\x81\x8f\x8e\xde\xa5\x74\x78\x2a\xfd\x15\x03\x17\x40\x74\x20\x6e\x7d\x7e\x18\x47\x57\xca\x66\x54\x53\x04\xe9\xcc\x2b\x4b\x7a\x94\xa0\xd0\xf6\x48\xa6\x31\xc7\x5a\xe3\xd6\x49\xcd\x05\xe5\x17\x28\x94\x9c\xa5\xfc\x8b\xc9\x2b\xc8\x96\x46\xec\xeb\x9a\x25\x59\x96\x57\x6f\x8b\x5c\xc2\x8c\x28\x0f\x92\x3e\xdd\x0c\xfb\xeb\x94\x44\x32\x25\x6c\x7b\xe3\x95\x69\x4b\x63\x5a\xf4\x96\x63\x5a\xbd\x06\xf4\xb6\xc4\xed\x96\x2d\x1d\x86\x1a\x2d\x23\xfa\x50\x27\x08\x6b\x66\x94\x9c\xb6\xde\xc3\x54\xef\x5e\x9c\xb7\xb7\x8e\x38\x98\x4b\xdc\xbe\x47\xaa\x9b\xdd\xef\x77\xc9\x98\xfa\x00\x6b\x9f\xb8\xdb\xea\xd9\xc0\xfa\x10\xca\x43\x0e\x68\x5b\xdb\xbd\xb7\x8e\x4e\xe1\xdf\xb5\x6b\x86\xe6\x77\x53\x89\x1c\x90\x18\x67\xf5\x3d\x60\x47\xed\x1b\xf4\xfa\x2b\x83\x81\xdd\x7d\x07\x39\xa3\x47\x55\xb6\xde\x74\x0d\x13\x60\xe2\xc6\x60\xd1\xb9\xb3\x8e\xfb\x81\xb5\x06\xd8\xef\x22\xfa\x06\xd8\xd1\x91\x18\x16\xee\x23\x1a\x35\x61\x97\xb3\x61\x54\x6b\x30\x0c\x93\xb5\x09\x4a\xf7\x16\xa2\xb1\xc1\x85\x96\xfa\x0c\x33\xa7\x1b\xa6\x2e\x5b\xc7\xe8\x57\xfa\x00\x68\xd8\x86\xfe\x67\x00\x58\xba\x0e\x08\xe4\x2c\x00\x00")
+
+func tplBridge_templateGoTwigBytes() ([]byte, error) {
+	return bindataRead(
+		_tplBridge_templateGoTwig,
+		"tpl/bridge_template.go.twig",
+	)
+}
+
+func tplBridge_templateGoTwig() (*asset, error) {
+	bytes, err := tplBridge_templateGoTwigBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tpl/bridge_template.go.twig", size: 11492, mode: os.FileMode(420), modTime: time.Unix(1792304867, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tplBridge_templateJavaTwig = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x59\x51\x53\xdb\x48\x12\x7e\xf7\xaf\xe8\xe5\xe5\xe4\xe0\x53\x62\x6a\x1f\xae\xe2\xb0\x57\x40\x80\xf5\x1d\x59\x52\xc6\xec\x56\x1d\xe5\x4a\x8d\xa4\xb6\x34\x41\x9e\x51\xcd\x8c\x70\x9c\x2c\xff\xfd\xaa\x67\x24\x59\x12\xb2\x70\xc2\xde\xf9\xc5\xc8\xea\xee\xe9\xef\xeb\x9e\x6f\x5a\x22\x63\xe1\x3d\x8b\x11\xbe\x7d\x83\xcf\xec\x81\x7d\x2c\x2e\x1f\x1f\x27\x83\x01\x5f\x65\x52\x19\x90\x2a\xf6\x59\xc6\xc2\x04\xfd\x50\xae\x56\x52\x68\x3f\x95\x71\xcc\x45\xec\x5f\xc9\x78\xb2\x9f\xd9\x05\x0b\x8d\x54\x9b\x2e\xeb\x84\x45\x52\x66\x7e\x28\xc5\xd2\x3f\x93\x62\xc9\xe3\x5c\x31\xc3\xa5\xe8\x31\x5e\x6a\xff\x23\x33\x49\x8f\x05\x97\xfe\x89\x52\x6c\xf3\x87\xe2\x86\x05\x29\xf6\x9b\x9e\x6e\x0c\xea\xfd\x4c\xe7\xf8\xc5\xf4\x5b\xec\x17\xa7\xb4\x3a\x93\xab\x8c\x29\x66\xa4\xda\xcf\xfe\xd6\xf0\x54\xf7\x98\xae\x58\xa6\x30\xca\x43\xf4\xe7\x4c\xdf\x9f\x18\x83\xab\xcc\x9c\x49\x61\x6c\xde\xa5\x1f\x15\xbb\x04\x6e\x79\x9a\x8a\x2c\x37\x37\x46\x21\x5b\x4d\x76\x1a\x5d\xe7\x66\xb7\xd5\x7b\x66\xd8\x54\xf4\xdf\xef\x0d\x70\xc1\x53\x7c\xf2\xe3\xf4\xfa\xfc\x4b\x88\x59\xa3\x1f\xec\x3d\x81\xc6\xbf\x9d\x4d\x27\x83\xc1\xeb\x57\xaf\x06\xf0\x0a\x3e\x28\xc1\x0c\x7f\xc0\x53\xc5\xa3\x18\x21\x94\xe2\x01\x95\xd1\x50\x12\xa7\xc1\x48\x60\x22\x82\xa5\x92\x2b\x30\x09\x42\xb0\x31\x08\x0a\x33\x85\x1a\x85\xb1\x3d\x47\x81\x72\x8d\x11\xd9\x66\x4c\x6b\x78\x60\x69\x8e\x1a\x62\x19\x70\x11\x41\x24\x51\x83\x90\x06\x74\x9e\xd9\x54\x8c\x84\x4b\xe9\x0f\xe0\xd5\xeb\x41\x96\x07\x29\x0f\x61\xc9\x05\x4b\x21\x4c\xc9\xb9\x95\xd2\xb7\x01\x00\x80\x4d\x97\x3e\xaf\xe0\x5c\xb8\xc4\xf4\x3d\xcf\x32\x2e\x62\x50\x18\x4a\x15\x69\x58\x27\x52\x23\x64\x4a\x86\xa8\x35\xdd\xc8\x98\xe0\xa1\x06\x2e\xdc\x72\xd6\xfd\xb5\xfd\x2e\x96\xd5\x94\x7f\xb9\xfa\x8d\x51\xe4\x74\xf3\xef\xe9\xc7\x4f\xa7\x27\xef\x3f\xcd\xce\xcf\xae\x67\xef\x6f\xe0\x18\x0e\x56\x45\x4a\x3e\xad\xe9\x07\x2c\xf2\x8b\x35\x0f\x26\x83\x76\x7a\xf3\x04\x41\xe4\xab\x00\x15\xc8\x65\x95\x1b\xb2\x30\x01\xc3\xf4\x3d\xac\xd8\xc6\xa6\x0e\x01\x2e\xa5\x42\x58\x32\x9e\xd2\x7e\xff\xd1\xfc\x3e\x5d\x4d\x3f\x4c\xe7\xbd\x59\xfa\x29\x5f\x71\xb3\x23\xd7\x58\xc9\x3c\xa3\x54\xa9\xb8\xa1\xcc\x85\x41\x05\x5c\x84\x0a\x57\x28\x0c\x46\xb0\x94\xca\xa5\x6f\x09\xc7\xa8\xc0\xf4\xe3\x09\x5f\xce\xae\x6f\x3f\xf6\x27\x5c\xe4\xe1\xdb\xe4\x76\x91\xcc\x56\xf8\x7f\xcd\xfb\xec\xfa\xf6\xb7\xf9\xf9\x6c\xbf\xcc\x29\xbb\xbd\xbb\x83\x36\x8d\xdb\x3e\x97\x12\x98\x01\x29\x42\xda\x68\xc0\x60\xc5\xb2\x0c\x15\xac\xb9\x49\x80\xc1\x2c\x17\x65\xa0\x15\x9a\x44\xee\x0f\xe6\xf4\x64\x7e\xf6\xeb\xa7\x9b\xe9\x7f\xce\x1b\xe9\x07\xcc\x84\x89\xaf\xf9\xd7\xe7\x73\xa5\x7d\xaf\xe9\x0f\x69\xe5\x08\x42\x99\xa6\x18\x12\xd1\xc1\x86\xd2\x2e\xda\x99\xa0\xd0\x82\xdc\x80\x91\x65\xa4\x7f\x91\xf6\xc0\x74\x09\x5f\x51\xc9\x91\xab\x4a\xc6\xb8\x02\x5e\x61\x67\x9a\x5c\xb8\x86\xb5\xe2\xc6\xa0\xf0\x61\x6a\x2f\x79\x2c\xa4\xc2\xa8\x8c\x14\x6c\xc0\x30\x15\xa3\x29\xb7\x3b\x09\x15\x82\x42\x93\x2b\xa1\x81\x09\x40\xa5\xa4\x1a\xc1\x3a\xe1\x61\x02\x4c\x21\x08\x7c\x40\x05\x41\xbe\x5c\xa2\xc2\xfd\x19\xfb\x63\x36\x9d\x9f\x7f\x3a\xbd\xbd\xb8\x38\x9f\x3d\x25\x8e\xb2\x44\xdf\x05\x6d\xf0\x97\x29\xfe\xc0\x0c\x36\x43\x5e\xc9\x18\xae\xae\x2f\xe1\x18\xb6\xa7\xba\x1f\xa3\xb9\x92\xb1\xd7\x94\x3b\xdf\x6a\xe0\xb0\x15\xac\x69\xe3\x0d\x0b\x51\x7c\x7c\x52\xb2\x53\x16\xcd\x8a\x9e\x8a\x30\xe4\x11\x12\x4d\x68\x12\x54\xc0\x8a\x6e\xdb\x21\x93\xf7\x18\x39\xa1\x2c\x43\x71\x5d\x6e\x9c\x11\xb0\x90\x3c\xc9\xd6\x48\xbb\xdf\x3e\xcb\x00\xc2\xfa\xd8\xf1\x3c\xad\x16\x58\x3d\x41\x87\xa1\x0e\xd3\x19\x06\x52\xa6\x48\x85\xb4\x3a\x1f\x4d\x76\x98\xa5\x52\xc4\x60\x85\x6d\x97\x45\x51\x48\xab\x21\xcf\xd8\x14\xbb\xf6\xa9\x95\x5d\xa5\xe0\x61\x32\xd8\xde\x76\x00\xb7\x68\xbc\xc6\x10\x66\xb9\x19\xd6\x10\xd2\xa7\xc0\x03\xc7\xf6\x2e\x95\xff\xd4\x21\xf5\xda\x4a\x33\x82\x25\x4b\x35\x0e\x27\x0d\x7f\x8b\xb5\xe6\x7d\x25\x45\xec\x75\x9f\x06\x23\x18\xbf\x69\x79\x5b\x16\x6a\xde\x5e\xb7\x2c\x8f\xb6\x3d\x7e\xd0\x8a\x50\x70\xd4\x1b\xa3\x90\xc8\x11\x1c\xd0\xad\x8f\xe7\xd5\x9d\x7a\xb0\xc7\x2d\x8f\x55\xeb\xba\x9e\x9b\x15\xdb\xd8\xa8\x1c\x81\x3b\x69\x2f\xbb\x96\x14\x90\xae\x63\xfe\x80\x02\xee\x71\x33\x7a\xd2\xc9\xf5\x50\x8a\x71\x92\x15\x1c\x81\x4e\x64\x9e\x46\x10\x60\x59\x47\x1f\xae\x45\xba\xd9\xbd\x21\xea\x61\xca\xbd\x31\x02\xa6\x41\x21\x8d\x2f\x4e\xf1\x28\x15\x52\x3d\xbb\x23\x47\xb5\xdd\xe2\xc3\x49\xf9\x67\x23\x1f\xb7\x16\xd7\x40\x03\x3e\x09\x9e\x88\x0a\x4a\x23\xbf\x66\xf8\xba\xdd\x63\xe5\x76\xa0\x98\xde\xd3\xe9\x14\x42\xf7\x3d\x82\xeb\xe0\x33\x86\xc6\x31\x53\x8d\x7f\x44\x40\x19\xa1\xc4\xd2\x6e\x4c\xbe\x04\xef\xa7\xb2\x3b\xff\xfc\x13\x7e\x2a\x0d\xe9\xa2\x80\x02\xbf\x1c\xbb\x06\x6c\x3b\xd3\xc7\xa9\xaf\x6b\xda\x66\xcf\x3c\x36\xae\x8a\x58\x87\x87\xed\xc6\xb2\x08\xa8\xa3\xce\x5c\x8f\x79\xb6\x5b\x47\x65\xcb\x0d\xfd\xea\x5c\xf7\xc6\xad\xae\xbc\xba\xbe\xf4\xd7\x4c\x09\xef\xa0\x9a\x07\x03\x16\x35\xba\xe6\x1e\x37\x70\x00\x87\xf6\xfb\x10\x0e\xde\xda\x0b\xa4\xe5\x3e\xa0\xd6\x8c\x04\xb5\x15\xb4\x00\x44\x6d\x58\x6f\xdb\x6e\xcd\xad\x9a\x36\x41\x1a\x7c\x79\xe4\x00\x09\x63\x4f\xcb\x35\xa1\xc8\x36\x94\x97\xa4\xae\x2b\xba\x3a\x60\xe1\x3d\xfd\xc6\xe8\x09\xa1\x26\xb9\x29\x1d\x6e\x0a\x4c\xc2\x44\x2d\x60\x8a\x22\x36\x49\x9f\xc6\xd2\xe9\x7c\xb7\xb0\x5f\xda\x6b\x3c\x98\xc1\xba\x5e\xb2\xd2\x0e\x8e\x61\x6d\x25\xc8\x3a\xd4\xe0\x53\x33\x04\xbe\x5b\x10\x8e\x0b\xab\x2b\x7b\xe9\x0d\xdb\xc5\x2f\x78\x0a\xda\x24\xd5\xee\xad\x7d\x82\xdf\x58\xe6\x71\xb0\x1b\x80\x91\xce\xb4\x3b\xfb\xce\x67\x2a\x0b\x46\xe0\xba\xfb\x6e\x1d\x9b\x51\x9b\x16\x80\xb5\x3b\xc8\x3d\x72\x6f\x3f\x6a\x79\x41\xbd\x2d\x1e\x21\xa4\x59\x09\xbc\xda\xb3\x15\x60\x9b\x10\x93\x28\xb9\xb6\xc9\x4c\xd3\x14\x63\x96\xde\x18\x66\xb0\x72\xf0\x70\xd8\x43\x55\xe0\x1b\x59\x61\x68\x91\xb5\xa3\xdf\x34\x2a\xce\x52\xfe\xd5\x9d\x3a\x72\x09\xda\x9e\x67\x1a\x98\x9d\x85\x1a\x0f\xf3\x74\x7b\x6e\x95\x82\xe9\x41\xa5\x4a\x2c\x72\x83\xe6\xa5\x84\xbb\x85\xf3\xde\xa3\xcf\xca\x32\xb9\xe3\xf3\x6e\x51\xae\x5b\xe7\x83\xd6\x22\x53\xfc\x62\x74\x51\x21\xfb\x53\x61\x5a\xf4\xd8\x62\x4b\x08\x0d\xec\x1e\x17\x06\x38\x1c\xc3\x9b\x09\x70\x78\x07\x4d\xe3\x09\xf0\xc3\xc3\x27\x9c\xd3\x02\x77\x7c\x51\x5b\xc3\x2b\xdc\xee\xf8\xa2\x8f\xf0\x12\x05\xb9\x35\xa8\xf2\x28\x88\x1b\xc5\x46\x2e\xfe\x70\xaf\x72\xa4\x32\x64\x29\x64\xcc\x24\xba\x7c\x32\x71\xc7\x55\xc4\x29\xa3\x20\x37\x18\x41\x48\x2f\x1e\x60\xc9\x53\xd4\x20\x55\x19\x86\xa9\x30\xe1\x0f\xa8\xcb\xc9\xf5\x57\xfb\x62\x02\x52\x2e\xee\x35\x70\x51\xcc\x5d\xf4\x04\xf9\x37\x0d\x6b\xa9\xac\x7a\x44\x5c\xa1\x1d\x26\x21\xa8\x44\xc4\x24\xc8\x15\xdc\xce\xa6\xb0\x54\x2c\x26\xcd\x04\xa9\xec\x72\xf6\x89\xa9\xaf\xb8\x55\x35\x2d\x0e\x7a\x57\xa4\xbd\xdb\xd9\xf4\x6e\x01\xb9\xe2\x8d\xda\x92\x4a\xd0\x6f\xa4\x10\x22\x4f\xd3\x1d\xc2\x40\xc4\x16\x41\xdf\x2c\xba\x0a\x51\xad\xe8\x38\x3b\xae\x7b\x50\xfc\xfd\x9a\xa4\x66\xd9\xd9\x21\x2e\xa0\x85\x0f\xc7\xd6\xfa\x8e\x2f\x48\xd7\x2e\x0a\x86\xbc\x96\xfe\x13\x3c\x67\xdd\x0d\x8f\x3e\x45\x34\x4a\x98\x98\xf2\x6a\x61\xed\xf5\x70\x48\x7f\xfe\xc6\x56\xd8\x8e\xde\x3c\x14\x2d\xf2\x6d\xfb\xd2\xfb\x1c\xbb\xb6\x75\x3f\x09\xb4\x4c\x73\x83\x2e\x62\x4f\x2b\xdb\x28\x3d\xf2\xfa\x6e\x0e\xf8\xc5\xa0\x88\xb6\xaf\x76\x7e\x81\xb9\x7d\xab\xe3\xf6\x40\x79\x30\x8c\x60\xde\xd4\xdc\x2e\xd1\x24\xd9\xb8\xe0\x98\x46\xba\x52\xce\xda\x4b\x2c\xaf\x21\xc6\xf5\x1b\xc1\xf0\x2f\x90\xd4\x13\x15\xe7\x54\xb4\x3d\x55\x75\xdd\xdc\xb8\xaf\xed\x63\xed\x52\xa6\xa9\x5c\xbb\xc9\x9f\xde\x24\x6e\x95\x14\xa3\xda\xbb\x2f\x6d\x98\x32\x64\xc5\x0c\x04\xe3\x3b\x3d\x5e\xd0\xd8\x56\x06\x0a\x8e\xee\xf4\xd1\x82\x4e\x7b\xcd\x35\x1d\xf8\xe9\xa6\x1a\x53\xb9\x2a\x23\xcf\xe5\xa8\x98\xf5\x56\x59\x6e\xd0\xe9\x84\x3b\x5e\xe5\xb2\x0c\xc5\xba\xd6\x6f\x2e\x7f\xa7\x17\x3e\xe5\xbe\x01\x4a\x37\xd7\xdb\x31\x54\xb1\x75\x19\x27\xac\xde\x8b\x5a\x01\x8a\x51\xa0\x62\x56\x74\x48\xcb\x50\xfb\x5d\xbd\x41\xbb\xa9\x48\xb6\x78\x22\xa9\x74\xb0\xec\x8a\xf1\xc8\x5a\xe9\xf1\xa8\x9a\x20\x8e\x8a\x9f\x8e\xea\x05\x2b\x38\x2f\xc2\xf8\x45\x54\xaf\xe0\xee\xa7\x63\x78\x33\x2a\x69\xa3\x8b\xbe\x81\x80\x82\x3b\x9e\x76\x25\x55\x24\xd0\xb1\xfe\xf8\x99\xb8\x25\xda\x8d\xc1\x17\x43\xdd\x18\x6c\xe1\x2c\x21\xee\x89\xae\x2b\x89\x17\x43\xbb\x49\xa4\x32\x2f\xc5\x66\x83\x54\xe0\x3c\x4d\x97\x43\x78\xfa\x0e\xde\xea\xc1\xad\xd0\x3c\x16\x18\x59\x27\x8f\x96\xd2\xe3\xe1\x08\xbe\xcf\xeb\x68\x44\x99\xec\x47\x5c\x37\xc4\xdd\xcc\x1d\xed\xc7\xdc\x54\xbc\x98\xb7\xa9\x30\x18\xa3\xaa\x98\xdb\x81\x7d\x2a\x6a\x3c\xf5\xd9\x7c\x0f\x2b\x5d\xe9\xef\xe6\xe4\xe7\xfd\x38\xa1\x57\x0d\x2f\x25\x85\x62\x3c\xc7\x08\xd9\x3c\x4b\x89\x33\xfa\x1e\x4e\x3a\xd3\xdf\x4d\xca\x3f\xf6\x23\xe5\x22\x95\xec\xc7\x5b\x65\x49\xde\xc0\xe0\x78\x17\x4c\x1b\xbe\x24\x63\xd2\xf2\x0b\x9f\xf5\x73\xfc\x4c\xda\xd8\x18\xbc\x83\x10\xfe\x09\x7f\x1f\xc3\x5b\xf0\x18\x0d\x38\x74\xf9\x06\xde\xc2\x78\x3f\x32\xbb\x61\xbf\xb8\xc5\xde\xcb\x3c\x48\x7f\x5c\x8d\x23\xeb\xde\xc7\xa7\x5b\xe0\x29\xa1\x85\x67\xf8\xbc\xe7\xff\x8c\xd2\x1d\xd8\x5f\xdc\xa1\xf6\x51\x68\x3f\x26\xed\x94\xa5\xa1\x3e\x89\x6d\x57\x25\x23\x31\xae\x11\x64\xff\x9d\xea\x47\x18\xca\x08\x7f\x9f\x0a\x73\xc3\xbf\x96\x87\xdf\x70\xd2\x74\x3b\x7a\xd6\xad\x7e\x56\xd6\x30\x76\x14\xa3\x76\x6c\xeb\xa2\x90\x70\x08\x62\xbc\x53\x2a\x7e\x6f\x48\xac\x2b\x20\x79\x1c\x3d\xe3\xf1\x3d\xe2\xd2\x20\xb9\x2a\x59\x2f\x9f\x2d\x84\xdd\xb4\xdc\xe9\xc5\x10\x0e\xfb\xf3\x1c\x81\x1e\xee\x3f\xea\xe8\x97\x4a\xf8\xde\x25\xf9\xb9\xff\x3c\x7b\x52\x90\x9f\xff\xba\xf3\xaf\x1b\x68\x8f\x3c\xc1\x61\xef\xda\x75\x8a\x1f\x07\xff\x1d\x00\x1e\xff\x3e\xf2\xfa\x21\x00\x00")
+
+func tplBridge_templateJavaTwigBytes() ([]byte, error) {
+	return bindataRead(
+		_tplBridge_templateJavaTwig,
+		"tpl/bridge_template.java.twig",
+	)
+}
+
+func tplBridge_templateJavaTwig() (*asset, error) {
+	bytes, err := tplBridge_templateJavaTwigBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tpl/bridge_template.java.twig", size: 8698, mode: os.FileMode(420), modTime: time.Unix(1792304072, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tplClass_templateJavaTwig = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xe4\x3a\xeb\x73\xdb\x36\xf2\xdf\xf5\x57\x6c\x35\x93\x86\x4a\x34\x4c\x7e\xbf\xbb\xf6\x8b\xe2\x4e\xe3\x34\x6e\xd5\x34\x4d\xc6\x4e\xae\x33\xd7\x76\x32\x10\xb9\x92\x10\x53\x00\x07\x00\x6d\xab\x3a\xfd\xef\x37\x78\x90\x04\x9f\x7a\x39\xbd\x5c\x4f\x1f\x12\x13\x58\x2c\x16\xfb\xde\x05\x52\x12\x5d\x93\x05\xc2\x66\x03\x1f\xc9\x0d\x79\xeb\x3e\xb7\xdb\xc9\x60\x40\x57\x29\x17\x0a\xb8\x58\x84\x24\x25\xd1\x12\xc3\x25\x89\x39\x4f\x43\xca\xc3\x47\x93\xee\xe9\x15\x49\x05\xc6\x59\x84\xe1\x8f\x7c\xb6\x17\xdc\x66\x03\xf6\xe3\x45\x42\xa4\xfc\x99\xac\x2c\x05\x7b\xac\x4c\xe8\x2c\xe4\x99\x4a\x33\x15\xbe\xce\x12\x45\xd3\x04\xdf\x98\x4f\x59\x1e\x40\x1f\x4c\xd3\x3c\x7d\xf3\xf2\x2e\xc2\x54\x51\xce\x26\x83\x41\x9a\xcd\x12\x1a\x41\xa4\x77\xcc\x8f\xef\x6f\x3f\x00\xf7\xc3\x3b\x85\x2c\x36\x30\x4d\x22\x9f\x6d\x36\x70\x8d\xeb\x29\xfb\x97\x25\xed\x83\x5a\xa7\x7a\x7c\xac\xc1\x6f\x48\x92\x61\xfb\xd4\x35\xae\xdf\x64\xaa\x73\x51\x73\xee\x1b\xd8\x0c\x06\x9b\x07\x40\xe7\xa0\x88\x58\xa0\x0a\x5f\xf0\x8c\x29\x14\xc1\x08\x1e\x58\x62\x53\x41\x6f\x88\x42\x77\x24\x37\xed\x93\xbf\xe0\x33\xca\x2c\xf9\x97\x9c\x2b\xd8\x6e\x35\xe7\xeb\xe8\x42\x7d\xb4\x60\xa4\x67\xaf\x54\x36\x83\x4d\xc1\x89\x7c\x83\x3e\x81\xe4\xdb\x46\x4a\x4c\x06\x8d\x95\xf9\x26\x7b\x62\x18\x79\x9b\xeb\x9f\x5a\x52\x19\x46\x4a\xc0\x99\xc5\x9f\x8f\x97\xc2\xda\x3c\x80\x39\x17\xb0\x02\xca\x9a\x07\x7b\x8d\x6a\xc9\x63\x59\x72\xcc\xad\xa0\x73\x58\xe5\xa7\x3e\x3b\x83\xe1\x3f\xb4\x08\x86\x1a\xa8\x3c\x80\xd5\x96\x84\xb3\x05\x98\xe9\xa0\x4e\x9b\x40\x95\x09\x56\x90\x18\x2e\x50\x39\xc0\x0e\x3a\x91\xc5\x74\xbe\x83\x92\x2b\x54\xdd\xc4\xdc\x70\x1a\x43\x0e\x11\x18\xd2\xc8\x4a\x75\xf1\x2c\x94\x39\xa4\x06\x3a\x81\xa6\x29\x8b\x04\xae\x90\xa9\x6e\xa2\x0a\x90\xdd\x54\xd1\x02\xf4\x40\xb2\x90\xc5\x5a\xd2\x6e\x70\x3b\xf0\xc1\x5a\xcd\x81\x29\xbc\x53\x2d\xe6\xa0\xee\x8c\x45\xf4\xe8\xfb\x7f\xd8\xf2\xc3\x9c\xf6\x48\xdd\xb5\xda\x94\x99\x0d\x3e\x23\x2a\x3b\xa4\x7d\x67\xec\xf6\xce\x17\x72\xe3\x34\x85\x8b\x86\x39\xa1\x49\x26\x70\x52\xf5\x7a\xbf\x08\xaa\xd0\x9c\x2e\xae\xda\x71\x8e\xa0\x16\x06\x9e\x1d\x75\x18\xe0\x79\x10\x69\xa8\x95\xfe\x3d\x79\xf4\xa8\xf8\x1b\x1e\xc1\xbb\xa5\xe0\xb7\x12\xd4\x12\x61\x4e\x85\xd4\x4a\x96\x1f\x42\x10\x2a\x31\x86\xd9\x1a\x08\xdc\x6a\xd2\x41\x52\x16\xa1\x81\x4d\x88\x54\x10\x91\x24\x19\xfb\xc8\xf4\xb1\x29\x5b\x18\x88\x28\x13\x02\x99\x02\xca\x6e\x78\x44\x34\xc2\xd0\x03\x7d\xd2\x6a\x7b\xd1\x12\xa3\xeb\x0b\xcb\xbb\x60\x04\xca\xd2\xe6\xc5\xbe\x31\x4c\xb5\x3f\x14\x59\xaa\x30\x2e\x46\x6b\x12\x2b\xc7\x11\xce\x4a\x51\xf8\x20\x6e\x10\xce\x80\x65\x49\x52\x9d\xa3\x73\x08\x10\x28\x93\x8a\xb0\x08\xf9\xdc\xdf\xbf\xae\x1c\x56\x41\x04\xbf\x85\xa0\x02\x55\xdb\x6e\x0b\x98\x48\x6c\xc1\xdc\x72\x98\xbe\x2d\x5a\xc1\xfb\xf6\xfa\xc2\x1e\xb0\x1b\x27\xc3\x5b\xff\x7c\x01\x8e\x6a\xd8\xda\x9d\x5a\xa9\xd3\x53\x79\x69\x42\x9f\x8b\xe5\x0d\x95\x36\x09\x4c\xa6\x68\x12\x4e\x15\x0a\xa2\xb8\x78\xd6\x69\xbd\xdf\x00\x55\x58\x09\xbd\xd5\x60\xa1\x31\x04\x06\x61\x42\xd8\xc2\x22\x9c\x25\xb8\x0b\x61\xab\x45\xeb\x09\x38\x33\xf3\x21\x75\x94\x1d\x14\xee\x9a\xa1\xda\xba\xb2\xfd\x43\xb5\x71\x07\x36\x10\x35\xdd\xc4\xa5\x09\xc9\xf2\xa5\x10\x5c\xd4\x9c\x85\xc7\x14\x03\x1a\x94\x6e\x42\x33\x27\x3f\x3c\x5c\x57\xdd\x44\x65\xee\xa6\xb0\xae\x2e\x2b\x8a\xd4\x5d\x78\x5b\x43\x7f\x2b\x48\x1a\x3c\xbc\x7e\x38\x6a\xf8\x20\x3b\x73\x63\x66\x2a\x5c\xdc\x3c\xb0\xfa\xf8\x09\x0e\xb0\x69\x98\x6d\x6e\xd6\x3d\x6a\x6f\x53\x9d\x2e\x25\xd7\x3f\x25\xd6\x2d\xeb\xee\x85\x1d\xfa\xb7\x85\x88\xa8\x68\x09\x81\xe7\xa6\xda\x08\x2d\x5d\x14\x76\x9b\x64\x77\x7e\xb1\x33\x15\x32\x9c\xd7\xdf\x71\x97\x0a\x9a\xc9\x03\xf4\xd0\x06\xb6\x2b\x25\x74\x08\x60\x64\x85\x86\x27\xfa\x8f\xf8\x15\xae\xdb\x44\x6b\xe6\x4c\x62\x77\xb0\x76\xea\x48\x61\x36\xb4\xb1\x32\xd0\xa8\x6a\x9c\x76\x71\xd0\x89\xad\x49\x50\x43\x7c\x1e\x39\x27\xe9\xf3\xbd\x32\xe2\xcf\xd5\xf2\x3d\xd8\xfa\x89\x58\xfb\x39\xd9\x86\x2b\xbb\x5a\x8b\x84\x23\x6a\xd1\xa2\x74\x74\x2a\xb1\x10\x3c\x4b\xc7\xe0\x29\x48\x47\x41\xa6\xa3\x73\xbe\x56\x3b\xa0\x05\xaa\xfc\xd3\xe1\x30\x8b\x4f\xaa\xd2\x14\x51\x99\x6c\x3d\xa9\xa3\xcf\x42\x74\xd5\x8c\x8e\xac\x1c\xe8\xb4\x7a\xb1\x87\x98\x3c\x07\x70\x1b\x39\xda\xa4\xf9\x1a\xb5\x84\x2e\x59\x80\x3a\x98\x13\x28\xfb\x1e\x55\x1f\x83\xbe\x47\x95\xd3\x73\x8d\xeb\x7e\x3e\xbd\xe0\x6c\x4e\x17\x99\x30\x09\x71\x30\xd2\x43\xc1\x35\xae\xc7\x30\x1c\x9e\x48\xe1\xb4\xa3\xa8\x35\x55\xac\x9d\xf7\xa8\x1c\xdb\xf1\x18\xe7\x07\xd3\xfb\x13\x67\x0b\x4b\xb3\x5e\x7d\x1a\xd1\xe7\x9c\x27\xad\x54\xcf\x38\x4f\x90\x30\x70\x30\x15\xca\xf3\xb9\x63\x88\x3f\xb7\x6b\xef\x8b\x7e\x4b\x56\xbb\xc6\xce\xd6\x0a\x7f\xfd\x1d\x4a\xa8\xdd\x4a\xf2\x5a\x30\xa2\xe8\x0d\x9e\x0b\x1a\x2f\x30\x54\xfc\x7c\xad\x50\x06\xdd\xc7\xc9\x31\x9b\xe3\x68\x6f\x61\x07\x7e\x7d\xfa\xfb\x49\x4e\xe1\x07\x22\x7f\xc6\x3b\xd5\x2b\x19\x07\xd3\xdb\x4b\x32\x49\xb6\x0b\x53\xf0\xe5\x97\xe5\x60\xb8\xcc\x57\x9f\x40\x65\x27\x89\x5e\x3d\x50\x89\xa6\x7d\xf4\x7a\x6b\x32\x66\x43\x54\x49\x2d\x33\x0b\x4d\xc4\x3a\xaa\xcb\xd3\xa8\x8a\xe6\x94\x91\x04\xbe\x23\x8a\x4c\x59\x9a\xa9\xf3\x6c\x3e\x47\x01\xa9\xc6\xc2\x16\x70\x66\x44\x59\x9b\xf5\x59\x55\x45\xd3\xd5\x25\xc8\xf1\xbd\xc2\xb5\x43\xd9\x05\xd9\x8b\xbb\xa3\xcf\x90\x63\x37\x41\xbd\xc4\xdf\x01\xad\x77\x38\xad\xca\x39\xd7\x99\x41\xe0\x8c\x6a\xb6\x33\x41\x74\xd4\x85\x02\x25\xaa\x60\x36\x86\x59\x98\x20\x5b\xa8\x65\x2d\xe5\xb8\x5d\xd2\x04\x21\xc8\xc1\x17\xa8\xde\x72\x49\xad\x85\xc1\xb3\x72\x55\x4b\x16\x52\xb2\x37\x14\x48\xe2\x0b\x8a\x49\x2c\x73\x4c\x2d\x49\x93\xcf\xb0\xfd\x56\x94\x45\x47\xb9\xd7\xb8\x82\x67\xd4\x9f\xff\xec\xca\x54\xeb\x4c\xfd\x44\xc9\xe6\xbe\xc2\x68\x4f\x4a\x4f\x10\xd1\x31\x62\x3a\x4e\x54\x47\x89\xab\xc9\xa9\x3f\x23\x03\x2e\x6d\xf0\x32\x63\xb2\xa3\x6f\x43\x99\x82\x99\x26\xe5\x8a\xfe\x81\x93\x1e\x07\x66\x0b\x05\xe7\xc1\xcc\x0a\xcf\x7f\xf9\x93\xc6\x05\xb4\xc7\x48\xed\x98\xad\x2a\xee\xb4\x6b\xb3\x83\x53\xa4\x1a\x37\xb5\xb3\x0d\x34\xdd\x14\xce\xe0\xe9\x04\x28\x3c\xb3\xe0\xfa\x08\x3a\xfc\x68\xf9\x68\x57\xfe\x0a\xd7\xf9\xf5\x06\xd0\xc7\x8f\x47\x1d\xe5\xbe\x0e\xb9\xb6\x89\xf9\x0a\xd7\xc1\xc8\x89\xd6\x60\x1c\x4d\x76\xac\x70\xf8\x7b\xd6\x6c\xdb\xa2\x50\xd9\x2d\x7b\x2e\x04\x59\xcb\x30\xe2\xe9\xfa\xcd\xdc\x22\xd0\xe8\x35\x53\x83\xd1\x18\x8a\x81\x9f\x8c\xee\x07\xfb\x85\xfb\xda\xed\x42\xb5\x94\x81\xed\x16\xe8\x2a\x4d\x26\x15\x98\x4a\xff\xde\x9f\xa8\xe5\x2a\xe7\x24\xbe\xc4\x88\x8b\x58\xc2\xac\xf8\x73\x57\xff\x7b\x50\x69\x48\xeb\x66\x34\xda\x4a\x31\x2f\x30\x21\xc6\x28\x21\x42\x0f\x30\x4b\xee\x95\x12\x59\xa4\xdc\x0d\x01\xdc\x52\xb5\x84\x6f\xcd\x12\xbb\x22\x1c\xf8\xfd\xe5\x9c\x56\x5d\x02\xd0\xc8\x29\x6d\xc9\xe3\x2b\x54\xcf\x6c\xc2\xf4\x0d\xfc\xfc\xfc\xf5\xcb\xef\x3e\xbc\x79\xff\xee\xed\xfb\x77\x57\x4e\x83\x4b\xc8\x1f\x88\x5c\x7a\xd0\x41\x43\x4e\x44\xfe\x44\xa5\x0a\xbc\x14\xec\x77\xd8\x0c\x5c\x9b\x50\xd3\xe7\x75\x0a\xbd\xfa\xba\xd6\x24\xd4\xbf\xa1\xab\x96\x61\xbb\x1d\x8e\x07\xcd\xcb\xa2\xd1\x68\xd2\xc2\x37\x63\x33\x84\xc1\x34\x49\x70\x41\x92\xe7\x62\x91\xe9\x8b\xa9\xd2\x8a\xe8\xdc\x91\x21\x81\x71\x05\xa4\xe4\xac\xe1\x5e\x8e\xc9\x71\x11\x34\x8d\x12\x52\x22\x25\xc6\xa0\xb8\xd7\xd7\x00\x22\x10\x38\x4b\xd6\xb6\x59\x80\x31\xdc\x2e\x91\x81\x5a\x22\x7c\xcf\x73\x34\x11\x8f\xcd\x56\x84\x91\x64\xfd\x07\xc6\x46\x09\x96\xb8\x36\x8b\x23\x6e\x3a\xe0\x4a\xf6\x0a\xab\xbc\x10\xf0\xfb\x11\x1d\x35\xb3\x8e\x54\x5f\x54\x44\x18\x46\x9c\x29\x42\x99\xb4\x2d\x8c\x66\x0f\xb8\xe8\x7e\x77\x70\x2c\x18\xfa\x9a\x08\xbf\x0d\x87\xf0\xd8\xec\x0b\x8f\x61\xf8\xdb\x30\x67\x64\xc1\xc6\x86\x2a\xb6\xab\x6c\xb3\xcc\x6b\xbb\xfe\x2b\xf2\xd8\xfa\xcd\x7e\x25\x7d\x95\x59\x5a\xcd\x0b\xb5\xf9\xc2\x99\x67\xd7\x9a\xd3\x7a\x73\x2e\xbc\x2c\xaf\x69\x77\x79\x65\x81\x12\x3e\xf2\x19\x28\x0e\x99\x44\x93\xad\xbb\x1b\x48\x22\x81\x2a\x73\xf1\xe8\x74\xf8\x15\x65\xb1\xe9\x6d\x54\x0c\xdc\x8d\xea\xe4\xdc\xdd\x0a\x0c\x81\xb0\xb8\xbc\x2b\x78\xc1\x57\x33\xca\xdc\x65\x81\x99\xca\x47\x3c\x1e\x8c\x81\x98\xe2\xd4\x70\x54\x2d\x31\xa7\x32\xa6\x52\x09\x3a\xcb\x14\xc6\x10\xe9\xfb\x77\x98\xd3\x04\xe5\x18\x88\x88\x96\xf4\x06\xa5\x41\x58\xf5\x1f\xb4\x90\x90\xb4\x4a\x5d\xd3\x74\xe9\x4e\x8a\xfa\xe0\x0f\xa5\x1b\xd5\x56\xbb\x22\x6a\xac\x53\x8f\x68\x09\xab\x4c\x2a\x98\x21\x48\x54\xf6\x92\xac\xa6\xb8\x56\x5a\x15\xbd\xcd\x19\x1a\xfc\xc8\x67\x1a\x75\xdb\x65\x96\x27\xc9\x8f\x7c\xa6\x3b\x16\x3f\x12\x71\xbe\x36\xe2\x0e\x5a\x64\x1f\x1a\x59\x8c\x6a\x4e\x75\x2a\x5f\x93\x34\xc5\x5a\xfa\xec\x10\xda\xa9\xdd\x18\x9b\xcb\xac\xbd\xbd\xc2\x92\x9c\xd6\xca\x61\x27\x06\x13\x08\x0b\x1c\x1d\xd5\x81\x7f\xb2\xc2\x12\x6a\xa7\xac\xa8\x4e\x7d\xbb\x7c\x72\x2f\xde\xb5\xef\xd0\xa6\xbb\xcd\x8d\xdc\xd4\xc1\x1c\x3d\x91\x9d\xf7\xc0\x4b\x1d\x43\x32\x41\xfd\x1b\x2b\x6d\x43\x17\xda\x84\x9a\x3c\x25\x71\x5c\x4c\xdb\x68\xc7\x50\x85\xef\x2f\xa7\x61\x24\x90\x28\x0c\x74\x98\xd2\xe8\xb4\x47\x2b\x36\x73\x71\xaa\x7b\xb7\xe7\xce\x52\xbb\x37\x74\x10\xc7\xec\xb9\xc7\x45\xbb\x2d\x30\x8e\x09\xcb\xb5\xab\x79\x4d\xaf\x1f\x97\x3e\xf2\xd9\xb8\x1a\xba\xcd\xa9\x16\xb9\xe8\x2e\x8c\x43\xb1\xb2\x1b\x55\x7b\xe5\x6d\xe2\xab\xf7\xcc\x7b\x45\xec\x65\x07\x95\x12\xc2\xea\x25\x57\x17\x3c\x63\x71\x4f\x3d\xb1\xfb\x26\xb8\x59\x4a\xb8\x18\xf2\xed\x9b\x1b\x14\x82\xc6\xe8\x22\x38\x57\x18\x29\x8c\xad\x13\x94\xa8\xb2\xf4\xf3\x79\xda\x61\xff\x3f\xea\x55\x81\x7d\xfe\x61\xbb\xf1\x06\x4b\x90\x63\xdb\xf7\x85\x87\xae\x0e\xf2\x88\x63\x31\xdd\xcb\x5b\x8f\x2a\x1d\x8d\x62\xaf\xcc\xc4\xf3\x4d\xbb\x92\xf6\x1c\x51\x4b\x9f\x71\x34\x39\xa8\x71\xf3\xe4\x09\xbc\x25\x54\x48\x93\xea\x31\xae\x60\x66\xaa\x3f\x8c\xc7\x20\x39\x10\x53\xb9\xea\x74\x49\x63\x01\x2a\x5d\xe5\x63\xdf\x99\xa8\x25\xda\x4c\x13\xd4\x92\x28\x58\x91\x18\x81\xaa\xb0\x92\xdf\xe8\xac\xdd\xf6\x2e\x0c\x5e\x5d\xdb\x05\x4f\x47\x93\xb6\x76\x47\x17\x78\xf7\x59\xc3\x85\x6d\x94\xd7\xf8\xf4\xcb\xe5\xf4\xdd\xcb\x0f\xe7\xef\x2f\x2e\x5e\x5e\x7e\xb8\x9a\xfe\xf3\xe5\x18\xbe\xfe\xea\xab\xbf\x7d\x3d\xea\x0f\x59\x6d\x81\xb9\xef\xf5\xa0\xe9\xf6\x5d\xa5\x09\x55\x20\xcd\xbf\x67\xe0\x91\x5a\xce\x56\x52\xbe\x39\x04\x16\xd8\x7b\x57\xb2\xeb\xd5\x29\xd5\xa8\x42\xed\xdb\x0d\xbe\xba\x43\x38\x70\xb9\x49\xc3\xe0\x0c\x82\x83\xb7\x35\x84\xd7\x5e\xdf\x38\x99\xbd\x23\xf2\xda\x17\x94\xfe\x7e\xae\x14\xae\x52\x35\xfd\x2e\x18\x85\x8a\xdb\x52\x40\x7b\x53\xbd\xbf\x69\x0b\x11\xb5\xec\x98\xba\x52\x44\x28\x7f\xa0\xad\x6e\xb6\x0a\xb4\x39\x99\x9e\xe1\x70\x0c\x4f\xc7\xf6\x74\x5d\x7b\xf5\xe9\xeb\x11\x7b\x3d\xed\xf0\x00\x39\x4a\x13\x5b\x83\x46\xd3\xa2\xe3\xc2\xa1\x36\x9c\xf0\x88\x24\x9a\xb9\x55\x37\xe1\xa5\x0f\xa3\xd1\xf8\xfe\x71\x97\xc9\xc2\xc8\x67\x5d\xc5\xc8\xae\x4c\x8c\xa9\x58\x98\x17\xe9\x84\x68\xbc\xf0\x6a\xb6\x18\x73\x16\x65\xa9\xbe\x68\x19\x4d\x9a\x81\xb4\x27\x76\xda\x2d\xb0\xad\xf9\xa2\x5d\x7e\xf5\x41\x5b\xd5\x6a\xf5\xd2\x8e\xae\x6a\x47\x44\x16\xe6\x65\xee\x6b\x94\x92\x2c\x50\x0b\x1f\x85\xd8\xb3\xef\xb3\x47\x1f\xa6\x37\x96\x47\xfa\xce\xe7\x2f\x12\xcd\x8b\x24\xd0\x7b\x8f\xee\x8e\xd7\x48\xfb\xfa\x95\xa9\xbb\x67\x6d\x94\x2a\xc7\x5a\x55\xab\x43\xda\xbc\x0d\xf5\x6a\x76\x0e\xfb\xd4\x6c\x0f\x55\x3b\x5e\xdd\xba\x7b\xcc\x5b\xdb\x69\x4b\xd6\x2d\xf7\xf2\xf9\xcb\x91\x28\xe1\xb2\xe5\x7d\x79\xe1\x17\x3b\x64\xf3\xbf\xa5\xa9\x47\xb9\xb2\x76\xad\xfb\xef\x74\x66\xb5\xde\x58\xc7\xe5\x45\x8b\x4e\x78\x17\x5d\x22\x63\x9f\x9d\x32\x34\x19\x74\x98\x62\xd8\xd2\xaa\x4c\xfe\xbb\x55\x42\x0b\xae\xbc\x04\x39\x83\x83\x73\xdf\xf3\xe7\xef\x5e\xfc\xe0\x92\xde\xff\x7b\xfa\xff\x7f\xaf\x79\x80\x93\x1c\xe4\x65\xc6\xfe\xd2\xce\x71\xa7\x43\xcc\x4d\xb5\x21\xc8\x9a\x37\xdc\xad\xe5\x85\x86\xdb\xd7\xc7\x79\xbb\xb8\x43\xc5\xc1\x3c\x18\xe9\x79\xc8\xbd\xc7\xdb\xea\x92\xb4\x4e\x18\xcf\x78\x2d\xc4\x18\xfe\x6a\x96\xd8\xc3\xc3\x8a\xf3\xcc\x9f\xaf\xdf\xd4\xae\x81\xdb\xe2\xe7\xa1\x6e\x7f\x1f\x1a\x0a\x9b\x2b\x2e\x07\x3a\x14\xc5\xbe\x7e\xf9\x40\xc4\x42\x06\x0f\xaf\x71\xed\xde\x70\x36\xcd\xb4\xa5\x80\x39\x75\x93\xc6\x23\x1c\x07\x61\x06\x7b\x09\x69\xa6\x20\x7f\x56\xb8\xd3\x17\xcb\x5f\x94\x8d\x96\x50\x5e\xd3\xc2\xa0\xc7\xd6\xce\x50\x88\xb1\xe5\xcb\x5b\xc2\xa8\xbe\x33\xd3\x25\xcd\x27\x0b\x93\xdb\x7f\x0f\x00\xa1\x09\x0b\x4c\x19\x3b\x00\x00")
 
 func tplClass_templateJavaTwigBytes() ([]byte, error) {
 	return bindataRead(
@@ -86,12 +152,32 @@ func tplClass_templateJavaTwig() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "tpl/class_template.java.twig", size: 3452, mode: os.FileMode(420), modTime: time.Unix(1460757734, 0)}
+	info := bindataFileInfo{name: "tpl/class_template.java.twig", size: 15129, mode: os.FileMode(420), modTime: time.Unix(1792304154, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _tplInit_templateGoTwig = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x55\xcb\x6e\xdb\x3a\x10\xdd\xfb\x2b\xe6\x1a\xc8\x85\x7d\x91\xeb\xec\x0d\x04\x6d\x91\x2e\x9a\x45\x13\xa0\x2d\xda\x65\x41\x53\x63\x87\x88\x44\x0a\x7c\xd8\x71\x0d\xfd\x7b\x67\xf8\x90\x2a\x57\x71\x37\xf5\xc2\x71\xe6\x71\x78\xe6\xf0\x68\xd4\x0a\xf9\x2c\x76\x08\xa7\x13\x68\xd1\x20\x74\xdd\x6c\x76\x73\xb3\x33\xeb\x1d\x6a\xb4\xc2\x23\xec\xcc\xff\x8d\xd5\xc2\xab\x3d\xc2\x26\xa8\xba\xe2\x02\xb8\x33\xda\xe3\x8b\x87\xd6\x9a\xbd\xaa\xd0\xc1\x46\x38\x25\x41\x51\xd4\x0a\xe9\x95\xd1\x70\x50\xfe\x09\x3e\x8a\xf6\x13\x56\x41\x22\x48\x13\x38\xe9\x40\xe8\x0a\x9c\x17\x3e\xb8\xd5\xcc\x1f\x5b\xec\xb1\x62\xf3\x56\x50\xed\x69\x06\xf4\x89\xc7\xc4\x26\xb0\xe8\x83\xd5\xd4\xdb\x47\x22\xba\x7f\x22\x7e\x44\x4c\xc3\xce\x9a\xd0\x46\x68\x1e\x63\x15\xfb\x73\xe9\x22\xe6\xae\xd3\x7c\xce\x5b\xa5\x77\xcb\x92\x2b\xe7\x7c\x8e\x7c\xfa\x63\x18\x57\x06\x6b\x51\xfb\x9e\x2a\x57\xa6\xb2\xc5\x32\xc3\xf4\xdd\xe8\x33\x80\x43\x7f\xa1\xbb\x94\x2d\x5c\xae\x4e\x64\x66\x5d\x96\xb4\xcc\xda\x5a\x74\xd4\xcc\xe3\xfe\xa6\x5f\xaf\x59\x2a\x9e\xd0\xec\xab\xa8\x03\x4e\x8e\xb2\x8f\x19\xe7\x8d\xc5\x8a\x3a\x63\xee\xae\xc0\x72\x77\x6c\xa5\xf1\x08\xf5\x97\xd9\x12\x60\x3f\x5a\x42\x99\x6a\x2f\xb5\x0b\x2a\x61\x8c\x65\x01\xb9\xd7\xd2\x62\xc3\x14\x54\xf9\x75\x86\x55\xe6\xd9\x1c\x39\x96\xe0\xfa\xae\x01\xaf\x9b\x9d\xae\x60\x6b\x2c\x44\x11\x98\x03\xfd\x75\x70\xd5\xb1\x80\xe4\x61\xfe\x77\xc5\x5f\xdf\xb3\x9b\x8b\xb7\x46\xa2\xca\x1c\x74\x2d\x4a\xb5\x25\xdb\x7a\x33\xd9\x9c\xb5\xbe\x84\x7b\xae\x7f\x8e\x13\x4b\xb5\x4d\x24\xff\xb9\x85\x79\x23\xda\x16\xed\x9c\x79\x66\x45\x3e\x08\xf7\x90\x78\xe5\x6b\xb2\xac\xc3\x96\x1c\x6c\x48\x17\x5b\x84\x21\xb6\x7b\xa1\x6a\xb1\xa9\xb3\xa7\x73\x1f\xdd\xd1\xc6\x98\xba\xa0\x8d\xa1\x48\x57\xcd\x81\x88\x91\xda\x72\x4f\x99\x24\x66\xee\x35\xcd\x41\x44\x51\x57\x74\xf0\x40\xed\x9b\x55\xf4\xd8\x1f\xf8\xdb\x81\xd1\x08\xb5\xa2\x2f\x92\x28\x5a\x29\xcd\x97\x50\x63\xe5\xe2\x19\x8f\x3d\x30\xfd\x7e\x0c\x9e\x70\xaf\xf9\xf4\xf1\x79\x29\x51\xdc\x3e\x25\x6a\x9c\x77\x94\x89\x97\x40\xd5\x6f\x29\xb8\x18\xa2\xb7\xa4\xa9\x34\xcd\x86\x88\xd9\xf9\x12\xde\xc0\xdc\xc6\x87\x84\x24\x5e\x8f\x9a\x5f\xbf\x40\x7e\xf8\x82\xf4\x70\x4a\x74\x1e\xf0\x30\x59\x45\x0e\x14\xac\x83\x20\x49\x0f\x93\x40\xd7\x24\xbc\xa8\x8e\xd1\x95\xc1\x91\xde\xdb\xa0\xe5\x6b\x78\x74\x07\xff\x4d\x9e\x93\xdc\x93\xae\x10\xfe\x9d\x2a\x21\xa2\x44\x75\x30\xd6\xa0\xc4\xe0\x2e\x1a\x84\x16\x06\x78\xf1\x9c\xaf\x8e\xee\xe3\x26\x59\xa9\x15\xca\xc6\xfd\x28\x2e\xf9\x99\xc5\x66\x39\xde\x41\x02\x05\x1e\x26\x6e\xf3\x46\x1c\x93\x27\xe0\x07\x5a\x03\x34\x6c\x43\x6b\xe4\xec\x00\x57\x6c\x62\x82\x6f\x83\x67\xa4\xe0\x68\xc5\xc5\x58\x7e\x55\x54\x83\x87\xa2\x52\x0b\x33\x2d\xc9\x92\x47\x39\x37\x57\xf4\xec\x84\xb7\x72\x5c\xfa\x97\x4b\xd3\x2d\x87\x25\xf9\xe5\xf1\xfd\xe3\x1a\xee\x9b\xb6\x8e\x1b\x46\xf0\x8c\xc9\xd4\x84\xb1\xea\x8d\x1d\x8f\xca\x6b\x07\x6b\x87\x49\xe3\xbc\x91\x47\x32\x27\x6d\xeb\x3a\x3d\x75\x64\x18\xe7\x8c\x54\xe4\x9d\xaa\xbc\xaa\x84\xe7\xba\x41\xe1\x6c\xd9\xb1\xc4\x16\x25\xf2\x9b\xb6\x09\xb5\x57\x44\xae\xc0\xed\x95\x18\x8b\x38\xbd\xaf\xfe\xa0\x68\x22\xfe\x8a\xa8\x7f\x43\x3c\x7e\x08\x58\xc0\x61\x4d\xa5\x26\xfe\xec\x61\x7d\x1b\x93\x29\xd3\xc7\xcf\x05\x4f\x99\x2e\x8b\x9e\x97\x53\xfa\xc9\xf0\x57\xdd\xcf\x00\x00\x00\xff\xff\x7a\xb6\x72\xd2\xb4\x08\x00\x00")
+var _tplGrouping_templateJavaTwig = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x54\x51\x8b\xdb\x46\x10\x7e\xd7\xaf\x18\x02\x21\x92\x2b\x74\xd8\xaf\x26\xd0\xf6\x28\xe1\x0e\xda\x84\xfa\x21\x0f\xa5\x84\x91\x34\x96\xf6\x2c\xef\x2e\x33\x23\x3b\xaa\xab\xff\x5e\x56\x92\x1d\xdd\xd5\xbe\xb6\x4f\x5d\x10\x42\xbb\x33\xdf\x7c\xdf\x37\xa3\xf5\x58\xec\xb0\x22\x38\x9d\xe0\x09\x0f\xf8\x69\xfa\xec\xfb\x75\x14\x99\xbd\x77\xac\xe0\xb8\xca\xd0\x63\x51\x53\x56\x63\xe9\x9c\xcf\x8c\xcb\x16\xeb\xdb\xc7\x7b\xf4\x4c\x65\x5b\x50\xf6\xe8\xf2\x75\x14\xdd\x2d\x16\x11\x2c\xce\x25\xee\x1b\x14\xf9\x05\xf7\xa1\x08\x54\xec\x5a\x2f\x30\x86\x83\xb1\xbe\x55\xd8\x51\x27\xd0\x8a\xb1\x15\x68\x4d\xf0\xc1\x85\xcc\xca\x6d\x94\xdb\x42\xa7\xc4\x2c\x82\xc5\x5d\xe4\xdb\xbc\x31\x05\x14\x01\xf1\x2a\x3c\x7d\x55\xb2\xa5\xc0\x67\x36\x8a\x79\x43\xf7\x6e\xef\x91\x51\x1d\xc3\x29\x8a\x00\x00\x3c\x9b\x03\x2a\x8d\x25\x72\x63\xcb\x21\x3f\xe4\x9a\xbd\x6f\xd6\x53\xd0\x58\xe7\x4a\x85\x38\x81\xd3\x10\x12\x96\xb4\x9e\x38\x3e\x9d\x82\x82\x07\xfb\xe7\xe8\xc6\x17\xed\xfc\xc0\x78\x60\x99\x82\x72\x4b\xc9\xfa\x92\x13\xaa\xc0\xfb\x59\x79\x67\x65\x10\xea\x78\x80\x1f\x23\xfb\x91\xc7\x60\x64\x58\x0b\xb8\x77\x76\x6b\xaa\x96\x49\xe0\xc9\xe5\xa0\x0e\x5a\x21\xd0\xda\xc8\x64\x07\x0a\x18\x95\xd1\xe0\x60\x65\x71\x91\x9e\x4d\x18\x77\x73\x6d\xa2\xa8\xa6\x80\x83\x33\x25\x14\x67\xec\xf8\xd1\xe5\x01\x7e\x2e\xf2\xc9\xe5\x99\x90\x3e\x22\xff\xd8\x0d\x4e\xc4\x57\x6c\x19\xc5\x26\xeb\x97\x59\x1f\x26\x36\xdf\xfa\xf0\xef\x20\x26\xfd\xdf\x7f\x3c\x10\xb3\x29\x69\xfc\xda\xb4\xde\x33\x89\x7c\x46\xb6\xc6\x56\x12\xbf\x61\x3c\x06\xbb\xe5\x4d\x32\xd7\x66\xac\x4e\xf2\x29\x7e\x31\x09\x79\x43\x80\x29\x5c\xd9\x7d\x26\xfa\x46\x4f\x61\xb7\x84\xf7\x70\xab\xe3\x09\xe0\xfa\x9f\x11\x56\xaf\x22\xe4\xdf\x10\x94\xbb\x19\xa3\xb0\x98\xb4\x65\x0b\xb1\xb1\x9a\x0c\x83\x94\xdd\x4f\x2a\x2f\x78\xad\x3d\x32\xfa\x2f\xc8\x95\xc4\xef\x76\xcb\x77\x09\xf4\x7d\x0a\x37\x8e\x57\xc3\xf1\xac\x6b\x3d\x14\xa8\x45\x0d\xf1\x4f\x5f\x0b\xf2\x6a\x9c\x05\x4a\x5e\x90\xd0\x9a\xdd\x11\x2c\x1d\xe1\xa1\x69\xa8\xc2\x66\xa3\xa8\x74\x49\x88\x29\xab\x48\x7f\x26\x11\xac\x28\x4e\x52\x98\x0f\x7f\x3f\x35\xf7\xf4\x16\xcc\x16\xac\xd3\x91\x56\xf6\x20\x9b\x02\x1b\xe4\x38\x81\xb7\x7f\x1f\xfd\x4f\x28\x42\x02\x42\x6c\xb0\x31\x7f\x50\x39\xde\x18\xea\xc2\x55\x81\x12\x2e\x8d\x0e\x90\x29\x05\x46\xad\x89\x41\x6b\xb4\x50\xd2\x39\xc1\xd8\xea\x0c\xa5\x35\xed\xc1\xd9\xa6\x0b\xd9\x17\xc0\x71\x1b\x2b\x34\xf6\xf9\xbf\xf2\x7c\xfe\xae\x4c\x57\xde\x29\xfd\xf6\x3b\xe4\xcb\x74\xd8\x96\xe9\xdd\x2c\x53\x38\x1f\xad\xa6\xa3\xe9\xdd\xac\xe6\x86\xfe\x97\x1e\x87\x1f\x26\x6b\xd5\x34\xd9\x0f\xcc\xd8\x49\x56\x38\xdf\x7d\xdc\xfe\x8a\xb6\xa2\x38\x30\x90\xe1\x81\xef\xa0\x59\x26\x29\xbc\x1e\xbe\x4a\x07\x46\xb2\x0a\xe1\xab\xe4\xff\x99\x01\xb2\xa5\xd9\x86\x8e\xf7\xd1\x5f\x03\x00\x7f\xc8\x08\x6a\x97\x06\x00\x00")
+
+func tplGrouping_templateJavaTwigBytes() ([]byte, error) {
+	return bindataRead(
+		_tplGrouping_templateJavaTwig,
+		"tpl/grouping_template.java.twig",
+	)
+}
+
+func tplGrouping_templateJavaTwig() (*asset, error) {
+	bytes, err := tplGrouping_templateJavaTwigBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tpl/grouping_template.java.twig", size: 1687, mode: os.FileMode(420), modTime: time.Unix(1792302526, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tplInit_templateGoTwig = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x56\x4d\x6f\xe3\x46\x0c\xbd\xfb\x57\xb0\x06\x52\xd8\x85\x1b\xdf\x53\x04\xed\x76\x53\x6c\x7d\xe8\xa6\x68\x16\xdb\x43\x51\x14\xb4\x4c\x39\x53\x4b\x33\xc2\x0c\xe5\x44\x15\xf4\xdf\x0b\xce\x87\x2c\xd9\x4a\x02\xec\x61\x7d\x10\xe4\x19\xf2\xf1\x0d\x1f\x49\x4d\x85\xd9\x01\xf7\x04\x6d\x0b\x1a\x4b\x82\xae\x9b\xcd\xd6\xeb\xbd\xb9\xd9\x93\x26\x8b\x4c\xb0\x37\xdf\x97\x56\x23\xab\x23\xc1\xb6\x56\xc5\x4e\x0c\xe0\xbd\xd1\x4c\xcf\x0c\x95\x35\x47\xb5\x23\x07\x5b\x74\x2a\x03\xa5\x99\x2c\x66\xac\x8c\x86\x27\xc5\x8f\xf0\x1b\x56\x7f\xd0\xae\xce\x08\x32\x53\xcb\xa6\x5b\x81\x63\xe4\xda\x01\xea\x9d\x20\xf1\x23\xc1\xbf\x66\x0b\x99\xd1\xb9\xda\xd7\x16\xc5\xf9\x7a\xc6\x4d\x45\x7d\x14\x0f\x9b\x63\x46\xd0\xce\x00\x00\x3c\x01\x0f\x07\x96\xb8\xb6\xda\x01\xf6\x2b\x3e\xae\xa0\xee\xd5\x91\x34\xec\xad\xa9\x2b\x09\xe6\x0f\x78\xed\xfd\xa3\xe9\xc2\xef\xad\xfc\x06\x38\xb6\x4a\xef\x97\x69\x2f\xc5\x79\x08\x64\x53\x18\xc1\xcd\x6a\x6b\x49\x73\x3c\x47\x40\x0c\x66\x8b\x65\x84\xe9\xbd\x89\xc3\x0e\x38\xe2\x57\xbc\x93\xd9\xc2\x45\xeb\x40\x26\xc1\x7c\x42\x77\x78\xc7\x4c\x65\xc5\x9b\xbb\x11\x97\xcd\x1d\x98\x7c\x84\xcb\xe8\x0e\x80\xc1\x38\xa0\x8f\xbc\x2f\x28\x7e\x20\x1e\x21\x1e\xb1\xa8\xa9\x07\x1d\x8a\x22\x62\x57\x64\xb9\x81\x03\x35\x2b\x30\x16\xe6\x73\x50\x79\x02\x52\x0c\xca\x81\x36\x2c\x67\x0d\x91\x3f\x10\x2f\x0e\xd4\xf4\xb9\xbd\x88\xbc\xd1\x5f\x14\x1c\x50\xca\xa7\x0f\xac\xd9\xb3\xd9\x51\x0e\x2a\x7f\x81\xc7\x46\x0f\xa9\xac\x82\xb1\xe6\xa5\x3c\x06\x84\x7e\x36\xa6\xf8\x62\x46\x09\x67\x6b\x4c\xf1\x36\x23\x09\x75\x41\x49\x5c\x97\xfe\x39\x20\xf5\xe0\x0d\xce\x8a\xd0\x94\x25\x82\xa3\x0a\xa5\x4b\x77\x81\xa7\x9b\x24\x9a\x90\x2e\xe4\xd3\x46\xd3\xcb\xfc\x62\xd4\x91\x80\x7f\xfd\x1d\xde\x66\x5d\x9c\x02\xa9\x09\x2b\x4b\x8e\x34\x3b\xc0\xcb\x96\xef\x9b\xd9\xff\x9b\x6a\xe6\xcf\x3e\xc9\x53\x3d\x16\xd2\xef\xd8\x58\xda\x81\xd2\x7e\xef\x7d\x82\x05\x80\xe0\xba\x18\xc9\xf8\x40\xfc\x39\xb8\xa5\x9e\x0b\x28\x53\xee\xc9\x76\x71\xc4\xc2\xd7\x43\x02\xd9\xe8\xcc\x52\x29\x14\x54\x7a\x3b\xc3\x4a\xe7\xd9\x36\xb2\x16\xe0\x7a\xaf\x13\x5e\x37\x6b\xaf\x20\x37\x16\x7c\x12\x84\x43\x53\x91\x83\xab\x4e\x12\xd8\xb6\xfe\xef\xb5\x3c\xfe\x89\x03\x38\x0d\xbd\x51\x52\xb3\xb8\xe8\x2a\xca\x54\xae\x32\x60\x33\xe9\x1c\x73\xfd\x1a\xee\x79\xfe\xe3\xba\xd0\x54\xf9\xc9\x0d\x6e\x6f\x61\x5e\x62\x55\x91\x9d\x0b\xdb\x3e\x2f\x55\xcd\xbf\x23\x3f\x8e\xe4\xaa\x64\x21\xd6\x5e\xae\x0a\x82\x2d\x29\xbd\x07\x4b\xb8\x4b\x89\x89\x6e\xa7\xf9\xd3\x5e\x01\x15\x8e\x06\xd8\xbf\xa2\xfb\x18\x4e\x1e\x91\x6d\xed\xeb\x13\xb5\xe1\x47\xb2\x29\xf5\x0e\xf0\x88\xaa\xc0\x6d\x11\xc7\x79\xf4\x5b\x8c\x1b\x67\x0c\xf5\x48\xa0\x65\xc1\x63\x04\xb7\xe8\x93\x72\xe5\x77\x36\x5a\x3e\x81\x42\x4d\xef\x54\x3e\xe0\xf6\xa7\x55\x4c\xf0\x24\x4f\x07\xd2\x37\x85\xd2\x04\x6c\x52\xbb\x49\x0a\x03\xac\xb7\xf4\x5d\x93\x90\x0f\xd4\xdc\xd7\x0c\x5d\xb7\x92\xf0\xe3\x80\x61\x63\xd9\xb6\x01\xfb\x17\x6b\x8d\x75\xf0\x23\xcc\x81\xe4\x75\x0e\x37\x32\x65\xbb\x2e\x76\xdc\x94\xb0\x3e\x23\xa3\x1d\x5f\x08\xeb\x35\xfc\x74\xb6\xf8\x72\x71\x88\x28\x75\xc6\xd0\x86\x30\x1f\xe9\x69\xd2\x2a\xb3\x84\x92\x00\x04\x4d\x4f\x93\x40\x2b\x2f\x7a\xe3\x2b\xbe\x76\x74\x3d\xcb\x6b\x9d\xbd\x84\xb7\x58\xc2\x77\x53\xeb\xb1\x32\x83\x78\xf0\xed\x94\x49\xeb\x33\xf2\x7a\xcd\xae\xd7\x32\x8c\x80\xf1\x10\x35\x3b\x50\xb3\x0e\x45\x54\xa1\xb2\xfe\x52\x80\xaf\xf5\x8a\x24\x51\xd2\xf1\x0e\x02\x28\xc8\x61\xfc\xec\x2f\xb1\x09\x82\xc1\x7f\x64\x0d\x18\x0b\xa5\xb1\xe7\x01\x5c\xaa\x0f\x53\x73\x55\xb3\x20\xd5\x4e\xfa\x42\xd6\xe2\xcd\x69\x77\x2a\x1e\x01\x87\x85\x99\x4e\xc9\x52\x8e\x72\x5e\x55\x1b\x3d\x5d\x54\x71\x3d\xe3\xe7\xd7\x4e\xf7\x76\xd1\x9d\x26\xf4\xa7\xfb\xbb\xfb\x1b\xd8\x94\x55\xe1\xc7\x5b\xbc\xa4\x01\x00\x04\x09\x86\x38\x57\x5d\x14\x6e\xd0\x46\x19\x3f\x5f\xf7\x8d\xe1\x19\xc7\xc9\x18\x47\xc0\x7a\x0d\xf1\xa3\x31\x52\x2b\x48\x54\x14\xe9\xf3\x86\xce\x99\x4c\xf9\x0f\x5e\xbc\xe6\x21\x8b\xdd\x49\x28\xeb\x51\xce\x94\xb2\x94\x91\xdc\x5f\xcb\xba\x60\x55\x15\x94\xe0\x8e\x0a\xc7\x5a\x4c\x8f\xd4\x37\x84\x09\xc4\x5f\xd0\xe6\xab\x68\x20\xcd\x26\x19\x3e\x0d\xc2\xe0\x24\xbf\x23\xdc\xdc\xfa\xcd\xb0\x33\x9b\xd2\xab\x37\x56\xb9\x44\x4f\x1e\x43\xc1\x96\x3f\xf8\x9d\x6f\x6e\x41\xab\x62\x00\x3f\xe8\x53\xb2\xb6\x5f\xee\xce\xc7\xbb\xfc\x2e\x30\x2f\x06\x6d\x37\xc9\x6e\x38\x0c\xb4\x2a\x86\x4e\xb3\xd1\xb0\x0e\xaf\x92\x8d\xab\xee\xff\x01\x00\xda\x3f\xed\xe1\xda\x0c\x00\x00")
 
 func tplInit_templateGoTwigBytes() ([]byte, error) {
 	return bindataRead(
@@ -106,7 +192,47 @@ func tplInit_templateGoTwig() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "tpl/init_template.go.twig", size: 2228, mode: os.FileMode(420), modTime: time.Unix(1461078756, 0)}
+	info := bindataFileInfo{name: "tpl/init_template.go.twig", size: 3290, mode: os.FileMode(420), modTime: time.Unix(1792302441, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tplPartitioner_templateJavaTwig = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x92\x51\x6e\xdb\x30\x0c\x86\xdf\x7d\x0a\xbe\xd5\x36\x0a\xe5\x00\xde\x86\x61\xc5\x30\x34\xc0\xb6\x02\x3d\x40\x41\xcb\x9c\xad\xc4\x96\x04\x89\x4a\x1a\x78\xb9\xfb\x20\xdb\xc9\x94\x2c\x1d\xaa\x37\x53\xff\xff\xf3\x23\x65\x8b\x72\x8b\x2d\xc1\x38\xc2\x06\x77\xf8\xb4\x7c\x1e\x8f\x55\x96\xa9\xc1\x1a\xc7\x60\x5c\x2b\xd0\xa2\xec\x48\x74\xd8\x18\x63\x85\x32\xa2\xac\xde\xbe\x1e\xd0\x3a\x6a\x82\x24\xb1\x36\xf5\xbb\x74\x4f\xe8\x58\xb1\x32\x9a\x5c\x95\x65\xab\xb2\xcc\xa0\x3c\x21\x3d\xf4\xe8\xfd\x0f\x1c\x22\x14\xd8\x93\xd0\xc3\x80\x16\x4c\x60\x1b\x18\x82\x57\xba\x05\xee\x08\xbe\x99\x68\x6b\xcd\x33\xbb\x20\x79\x71\x89\x0c\xca\x55\x66\x43\xdd\x2b\x09\x32\xc6\xdd\xcc\xa6\x57\x26\xdd\x78\x48\x60\x3e\x8c\x23\x6c\xe9\xf0\xa8\x7f\xcf\xc8\x2f\x7c\xb0\x51\x7b\x1f\x03\x76\xd8\x07\xfa\xe7\xea\x13\x8c\x59\x06\x00\x60\x9d\xda\x21\xd3\xcc\x53\x2b\xdd\x4c\xcd\x62\x23\x35\xd8\xbe\x5a\x44\x33\xd4\x0d\x9c\xbc\x80\x71\x92\xc4\xe3\x83\x25\x97\x17\xd5\xb9\x10\x23\xe0\x63\x92\x6d\xb4\x9f\x46\x36\x6e\xf2\xce\xca\xe3\xdc\x64\xda\x67\x3c\x25\x3c\x18\xfd\x4b\xb5\xc1\x91\x87\x8d\xa9\x81\x0d\x04\x4f\xc0\x9d\xf2\xcb\x62\xd0\x83\xe2\x8b\x1d\x88\xc5\xbb\x4a\x81\x3d\x23\x2b\x09\x3b\xa3\x1a\x90\xa7\xcc\x7c\x6d\xea\x18\x9b\x92\x6f\x4c\x2d\x3c\xf1\x1a\xdd\x97\xc3\x34\x5e\x7e\x63\x56\x31\xf5\x2e\xaa\x6b\x57\x42\xf1\x3e\xef\x32\xf0\xe7\x9f\x3b\x72\x4e\x35\x94\x22\x2b\xcd\xd0\x26\x99\xf9\x1b\x6f\x1b\x8b\xff\x79\xdf\xb9\x7c\x3f\xc5\xe9\x30\x9c\xe3\x7c\x3a\x35\xbb\x43\xf2\x15\x8f\x23\x0e\x4e\x43\xae\x34\x17\xd3\xeb\x89\x1b\x20\x41\xef\x1d\xda\x17\x74\xad\xcf\xef\xb6\x74\xb8\x2b\xae\xff\xb5\x0b\xc5\x54\x5c\x34\x97\x28\x7f\x37\x79\x04\x89\x2c\x3b\xc8\xbf\xbe\x4a\xb2\xf1\x16\xa8\xb8\x62\xe3\xce\x99\x3d\x68\xda\xc3\x63\xdf\x53\x8b\xfd\x33\x23\xd3\xd9\x90\x93\x68\x89\xbf\x93\xf7\xd8\x52\x5e\xdc\x03\xa5\xf9\xcb\xde\x8f\xd9\x9f\x01\x00\xb0\x89\x0a\x3e\x4a\x04\x00\x00")
+
+func tplPartitioner_templateJavaTwigBytes() ([]byte, error) {
+	return bindataRead(
+		_tplPartitioner_templateJavaTwig,
+		"tpl/partitioner_template.java.twig",
+	)
+}
+
+func tplPartitioner_templateJavaTwig() (*asset, error) {
+	bytes, err := tplPartitioner_templateJavaTwigBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tpl/partitioner_template.java.twig", size: 1098, mode: os.FileMode(420), modTime: time.Unix(1792302369, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _tplStruct_templateJavaTwig = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x57\x4f\x6f\xeb\x36\x0c\xbf\xfb\x53\x70\x05\xfa\x62\xa7\x81\xfb\x92\x1d\x76\xc8\x32\x6c\xeb\xfe\xa0\xc3\xb0\x0e\xdd\x03\x76\x28\x8a\x41\xb6\xe9\x58\x6f\x8a\xe4\x4a\x72\xf3\xba\xcc\xdf\x7d\x90\xac\xc4\x8a\xab\xb8\x19\xb0\xe9\xd0\xca\x92\x48\xfe\x48\xfe\x44\x31\x35\xc9\xff\x24\x6b\x84\xdd\x0e\x3e\x92\x67\xf2\xab\xfb\x6c\xdb\x65\x14\xd1\x4d\x2d\xa4\x06\x21\xd7\x29\xa9\x49\x5e\x61\x5a\x91\x42\x88\x3a\xa5\x22\x9d\xf6\xfb\x46\xce\x2c\x7d\x47\x34\xb9\xe5\x75\xa3\x97\xa1\x9d\xbb\x46\x87\xb6\x6e\xef\xbe\xff\x94\x63\xad\xa9\xe0\xcb\x28\xba\x9e\x4e\x23\x98\xee\xc1\xdc\x30\xa2\xd4\x2f\x64\x63\xe0\x40\x25\x58\xa1\x40\x57\x08\x3f\x0a\xd0\x2f\xb5\x85\x6c\xfe\xa7\xe6\x44\x9c\x40\xdb\xa6\x11\x4c\xaf\xa3\xba\xc9\x18\xcd\x21\x37\xc2\x41\x4d\x74\x53\x33\xdc\x20\xd7\x0a\x76\x97\x40\x4b\xc8\xc5\xa6\x26\x92\x64\x0c\xe1\xb2\xfd\x5d\x52\x6d\xa6\x37\x87\xc5\x2f\x03\x4a\xbe\xda\x5d\x02\x32\xe5\x0b\x98\x15\x5e\xd0\x12\x2e\x5b\xd8\x45\x01\xcd\x11\x00\x80\xd2\x44\xd3\x1c\x76\xf6\xc3\x8c\x81\x3d\x2d\x64\x5a\x60\x49\x39\xc6\x01\xb3\xa9\xf5\x6a\x06\x1c\xb7\xd0\x0b\xc4\x49\xb2\xb4\xfa\xda\x28\xf2\x50\x98\x79\x29\x24\x94\x40\x79\x17\xa9\x1f\x28\xb2\x42\xc5\xc9\x1e\x8c\x0b\x55\x49\x39\x61\xb0\xdb\x41\x99\x7e\x78\xa9\x31\x4e\xfe\xee\xf2\xfc\x87\x11\x82\xb6\xed\xb6\x7e\x22\xcf\xe4\x10\x6a\x58\x59\x0c\xa7\x65\xe2\x64\xe9\xb0\x18\x08\x97\x6d\x64\x0d\x7e\x7d\xf7\x8c\x52\xd2\x02\x7d\xf3\xcf\x82\x16\xb0\x95\x54\x63\xdc\x13\x05\x44\xa3\x13\xd0\x95\x14\x5b\x05\x1e\x49\xba\xd0\x8e\xbb\x65\xc6\x6b\xc8\x69\x67\xc2\xe8\x1d\x40\x73\xa1\x1b\x07\x28\x91\x14\xce\xd0\x81\xe8\x40\xf9\x7f\x8c\xd1\xb3\x42\x79\x18\x67\x88\x59\xa7\xb1\x53\xae\xdd\x59\xfc\x20\x42\x94\x02\x91\x78\x6c\xb4\xc7\x61\x05\xef\x97\xe7\xb9\x40\x4b\x88\x73\x58\xad\xe0\xbd\xaf\xc5\x0c\xa3\x25\xe0\x60\x8f\x45\xa4\xaf\xb7\x1d\x8f\x0f\x8e\x1e\xfb\x6e\x86\x44\xdd\x48\x0e\xf9\x32\xf2\x0f\x75\x7c\x3f\x1d\x85\x4c\x08\x86\x84\x03\x3e\x35\x84\xa9\xf8\x2e\xfb\x88\xb9\x1e\x78\x5e\x42\xfc\x59\x2c\x80\x72\xa5\x09\xcf\x51\x94\xa1\xe2\x91\x0c\xdd\x74\x78\x4a\xc2\x14\xfa\xe0\xbd\x1c\xbf\x8e\xb8\xae\x50\xc2\x0a\x42\xe9\x48\x40\xf4\x6a\x7a\xd8\xb0\x02\x2d\x1b\x3c\x33\x2b\xf6\x3c\x3e\xc1\xbb\x77\xa1\x14\xb8\x20\x58\x14\xe1\x1c\x8c\x45\x1e\x9f\x96\x6f\x5e\x18\xc3\xa2\x8a\xa8\xea\x46\x14\x18\xfb\x11\xb3\x1b\xb0\x82\xf9\x17\x67\x7a\x62\x0e\x7f\x3e\x87\x29\x54\x70\x15\xf2\xa5\xb7\x32\x8e\xba\x7a\x1b\xf4\x6f\x5a\x52\xbe\x06\x2d\xba\xc9\x11\xee\x6e\xe9\xdb\x86\xb2\x02\x25\x64\xae\xfa\x1d\xad\xc6\x17\xbb\x0b\x8f\xbe\xdd\x1e\x28\xac\x61\x05\x17\x17\x67\xba\x9b\xa5\xa4\xae\x91\x17\xb1\xc2\x3a\xd9\xcf\x47\xaf\x89\x33\x00\x17\xe3\xfe\x1f\x14\x4f\xda\x49\x92\xf6\x3e\x2e\xdf\x2a\x2a\xf6\x45\x36\x63\xea\x1e\x1c\x54\xa0\x50\x52\xc2\xe8\x5f\x58\x84\xe8\xad\x40\x69\x22\xb5\xf1\x9e\x68\xc8\xe6\x0f\x6a\xfe\x08\x84\x17\x90\x2d\x1e\xd4\xe2\x71\xaf\x2d\x17\x5c\x51\xa5\x91\x6b\xf6\x02\x5b\xaa\xab\xbe\x4c\xa5\xee\xcc\xb5\x9f\x1e\xf7\x70\x7a\xf5\xec\x9e\x6c\xe3\xec\x45\xe3\xc3\x23\x64\xf3\x99\xdd\x51\xf3\x19\xec\x97\x16\x6e\x69\x71\xa2\x44\xff\x8f\x25\xaf\x7b\x11\xd3\x7b\xb2\x75\x31\x8b\x27\xd9\x7c\x32\x83\x89\xb2\x7f\xb3\x85\x9d\x2f\x26\x89\xed\xb3\x7c\x15\x6a\x0e\x57\xaf\x94\xfc\x8c\x7c\xad\x2b\x4f\x47\x40\x6e\x31\x26\x17\xb6\x77\x66\x85\x1d\xd2\xe0\xde\xee\x77\xad\x18\xb3\x16\x40\x94\xf6\x6b\x9c\x17\xc7\xb4\x78\x50\x8f\x6f\xa5\xb9\x53\xee\x67\xd9\x65\xf4\x8c\x84\xb2\x7f\x91\x50\x36\x16\x3a\x1b\x39\xb8\x02\xe6\x82\x37\x16\x32\x76\x2a\x64\x7d\xab\x06\xf9\xb9\x97\xc8\x5c\x09\xd1\xe8\xbd\x8a\x02\xf7\x02\xb6\x48\x55\xb8\x19\x0b\x5f\xd7\xfc\x7a\x66\xf1\x93\x46\x5e\xa8\x40\xb7\xe9\x05\xce\xe9\xf0\x1b\xcb\x01\xc3\x55\x53\xa3\x3c\xdd\x98\x1e\x3d\xdf\x87\xe9\x71\xb5\x0d\xf7\x26\xa1\x8b\x6c\xf3\x18\xba\xd0\x6e\x6b\x31\x44\xa7\xe5\xcb\x60\xc5\xe7\xb3\x57\x34\xe6\xb3\xae\x52\x2c\x66\xa6\x3c\x1c\xdf\xa4\x16\x72\xa2\xf3\x0a\x62\x9f\x5c\x98\x04\x14\x5b\x12\xda\x87\xe0\x96\x31\x5c\x13\xf6\x8d\x5c\x37\xe6\x77\xc5\x41\x2e\xc6\xa1\xf2\x41\x87\x70\xdc\xbf\xb4\xd1\x3f\x03\x00\x4f\xf7\xcf\x1e\x8e\x0d\x00\x00")
+
+func tplStruct_templateJavaTwigBytes() ([]byte, error) {
+	return bindataRead(
+		_tplStruct_templateJavaTwig,
+		"tpl/struct_template.java.twig",
+	)
+}
+
+func tplStruct_templateJavaTwig() (*asset, error) {
+	bytes, err := tplStruct_templateJavaTwigBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "tpl/struct_template.java.twig", size: 3470, mode: os.FileMode(420), modTime: time.Unix(1792301220, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -163,8 +289,14 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"tpl/class_template.java.twig": tplClass_templateJavaTwig,
-	"tpl/init_template.go.twig":    tplInit_templateGoTwig,
+	"tpl/array_template.java.twig":       tplArray_templateJavaTwig,
+	"tpl/bridge_template.go.twig":        tplBridge_templateGoTwig,
+	"tpl/bridge_template.java.twig":      tplBridge_templateJavaTwig,
+	"tpl/class_template.java.twig":       tplClass_templateJavaTwig,
+	"tpl/grouping_template.java.twig":    tplGrouping_templateJavaTwig,
+	"tpl/init_template.go.twig":          tplInit_templateGoTwig,
+	"tpl/partitioner_template.java.twig": tplPartitioner_templateJavaTwig,
+	"tpl/struct_template.java.twig":      tplStruct_templateJavaTwig,
 }
 
 // AssetDir returns the file names below a certain
@@ -209,8 +341,14 @@ type bintree struct {
 
 var _bintree = &bintree{nil, map[string]*bintree{
 	"tpl": &bintree{nil, map[string]*bintree{
-		"class_template.java.twig": &bintree{tplClass_templateJavaTwig, map[string]*bintree{}},
-		"init_template.go.twig":    &bintree{tplInit_templateGoTwig, map[string]*bintree{}},
+		"array_template.java.twig":       &bintree{tplArray_templateJavaTwig, map[string]*bintree{}},
+		"bridge_template.go.twig":        &bintree{tplBridge_templateGoTwig, map[string]*bintree{}},
+		"bridge_template.java.twig":      &bintree{tplBridge_templateJavaTwig, map[string]*bintree{}},
+		"class_template.java.twig":       &bintree{tplClass_templateJavaTwig, map[string]*bintree{}},
+		"grouping_template.java.twig":    &bintree{tplGrouping_templateJavaTwig, map[string]*bintree{}},
+		"init_template.go.twig":          &bintree{tplInit_templateGoTwig, map[string]*bintree{}},
+		"partitioner_template.java.twig": &bintree{tplPartitioner_templateJavaTwig, map[string]*bintree{}},
+		"struct_template.java.twig":      &bintree{tplStruct_templateJavaTwig, map[string]*bintree{}},
 	}},
 }}
 
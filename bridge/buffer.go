@@ -0,0 +1,42 @@
+package bridge
+
+// A Buffer collects the key/value pairs written by a target in their
+// Writable serialization, so they can be passed to Java in bulk rather
+// than one call per pair.
+//
+// Pairs are encoded with the embedded Encoder. Written must be called
+// after each pair, flushing the buffer once it holds Size bytes.
+type Buffer struct {
+	Encoder
+
+	// Size is the number of bytes collected before the buffer is flushed.
+	// If Size is zero, the buffer is disabled and pairs should be written
+	// to Java directly.
+	Size int
+
+	// Send passes the collected pairs to Java.
+	Send func(b []byte) error
+}
+
+// Enabled returns true if pairs should be collected in the Buffer.
+func (b *Buffer) Enabled() bool {
+	return b.Size > 0
+}
+
+// Written flushes the Buffer if it holds at least Size bytes.
+func (b *Buffer) Written() error {
+	if len(b.buf) < b.Size {
+		return nil
+	}
+	return b.Flush()
+}
+
+// Flush passes any collected pairs to Java and resets the Buffer. The
+// Buffer is reset even if Send fails.
+func (b *Buffer) Flush() error {
+	if len(b.buf) == 0 {
+		return nil
+	}
+	defer b.Reset()
+	return b.Send(b.buf)
+}
@@ -0,0 +1,35 @@
+package bridge
+
+import (
+	"fmt"
+	"math"
+)
+
+// An OverflowError is the panic value used when a value cannot be
+// represented by the type it is converted to.
+type OverflowError struct {
+	Value string
+	Type  string
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("bridge: %s overflows %s", e.Value, e.Type)
+}
+
+// ToInt64 converts v to an int64, panicking with an *OverflowError if v
+// is larger than math.MaxInt64.
+func ToInt64(v uint64) int64 {
+	if v > math.MaxInt64 {
+		panic(&OverflowError{fmt.Sprint(v), "int64"})
+	}
+	return int64(v)
+}
+
+// ToUint64 converts v to a uint64, panicking with an *OverflowError if v
+// is negative.
+func ToUint64(v int64) uint64 {
+	if v < 0 {
+		panic(&OverflowError{fmt.Sprint(v), "uint64"})
+	}
+	return uint64(v)
+}
@@ -0,0 +1,272 @@
+// Package bridge supports the Go code generated by go-mrnative.
+//
+// Values that gobind cannot pass directly, such as slices, are passed
+// between Java and Go as byte slices holding the Hadoop Writable
+// serialization of the value. An Encoder and Decoder read and write that
+// format in Go.
+//
+// Unsigned values, which Java lacks, are passed as signed values. ToInt64
+// and ToUint64 convert those that may not fit.
+//
+// A Buffer collects the pairs written by a target, passing them to Java
+// in bulk.
+//
+// A Task describes the task attempt and input split a target is
+// processing.
+//
+// Panics raised while processing a record are recovered by Recover, or by
+// a Guard recording that the record panicked, and returned to Java as a
+// *PanicError.
+package bridge
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// A DecodeError is the panic value used when a Decoder encounters
+// malformed input.
+type DecodeError struct {
+	Offset int
+	Msg    string
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("bridge: decoding at offset %d: %s", e.Offset, e.Msg)
+}
+
+// A Decoder reads values in the Hadoop Writable format, as written by
+// java.io.DataOutput and org.apache.hadoop.io.WritableUtils.
+//
+// Decoding malformed input panics with a *DecodeError.
+type Decoder struct {
+	buf []byte
+	off int
+}
+
+// NewDecoder creates a new Decoder reading from buf.
+func NewDecoder(buf []byte) *Decoder {
+	return &Decoder{buf: buf}
+}
+
+// Len returns the number of unread bytes.
+func (d *Decoder) Len() int {
+	return len(d.buf) - d.off
+}
+
+// next returns the next n bytes.
+func (d *Decoder) next(n int) []byte {
+	if n < 0 || d.Len() < n {
+		panic(&DecodeError{d.off, fmt.Sprintf("need %d bytes, have %d", n, d.Len())})
+	}
+	res := d.buf[d.off : d.off+n]
+	d.off += n
+	return res
+}
+
+// Bool reads a BooleanWritable.
+func (d *Decoder) Bool() bool {
+	return d.next(1)[0] != 0
+}
+
+// Int8 reads a ByteWritable.
+func (d *Decoder) Int8() int8 {
+	return int8(d.next(1)[0])
+}
+
+// Int16 reads a ShortWritable.
+func (d *Decoder) Int16() int16 {
+	return int16(binary.BigEndian.Uint16(d.next(2)))
+}
+
+// Int32 reads an IntWritable.
+func (d *Decoder) Int32() int32 {
+	return int32(binary.BigEndian.Uint32(d.next(4)))
+}
+
+// Int64 reads a LongWritable.
+func (d *Decoder) Int64() int64 {
+	return int64(binary.BigEndian.Uint64(d.next(8)))
+}
+
+// Float32 reads a FloatWritable.
+func (d *Decoder) Float32() float32 {
+	return math.Float32frombits(binary.BigEndian.Uint32(d.next(4)))
+}
+
+// Float64 reads a DoubleWritable.
+func (d *Decoder) Float64() float64 {
+	return math.Float64frombits(binary.BigEndian.Uint64(d.next(8)))
+}
+
+// VLong reads a variable-length integer, as written by
+// WritableUtils.writeVLong.
+func (d *Decoder) VLong() int64 {
+	first := int8(d.next(1)[0])
+	if first >= -112 {
+		return int64(first)
+	}
+	neg := first < -120
+	var n int
+	if neg {
+		n = int(-(first + 120))
+	} else {
+		n = int(-(first + 112))
+	}
+	var res int64
+	for _, b := range d.next(n) {
+		res = res<<8 | int64(b)
+	}
+	if neg {
+		return ^res
+	}
+	return res
+}
+
+// Text reads a Text.
+func (d *Decoder) Text() string {
+	n := d.VLong()
+	if n > math.MaxInt32 {
+		panic(&DecodeError{d.off, fmt.Sprintf("invalid length %d", n)})
+	}
+	return string(d.next(int(n)))
+}
+
+// Blob reads a BytesWritable.
+func (d *Decoder) Blob() []byte {
+	return d.next(d.Len32())
+}
+
+// Len32 reads the length of an array, as written by ArrayWritable.
+func (d *Decoder) Len32() int {
+	n := d.Int32()
+	if n < 0 {
+		panic(&DecodeError{d.off, fmt.Sprintf("invalid length %d", n)})
+	}
+	return int(n)
+}
+
+// An Encoder writes values in the Hadoop Writable format, as read by
+// java.io.DataInput and org.apache.hadoop.io.WritableUtils.
+type Encoder struct {
+	buf []byte
+}
+
+// NewEncoder creates a new Encoder, ready for use.
+func NewEncoder() *Encoder {
+	return &Encoder{}
+}
+
+// Bytes returns the encoded bytes.
+func (e *Encoder) Bytes() []byte {
+	return e.buf
+}
+
+// Reset discards the encoded bytes, retaining the underlying storage.
+func (e *Encoder) Reset() {
+	e.buf = e.buf[:0]
+}
+
+// Bool writes a BooleanWritable.
+func (e *Encoder) Bool(v bool) {
+	if v {
+		e.buf = append(e.buf, 1)
+	} else {
+		e.buf = append(e.buf, 0)
+	}
+}
+
+// Int8 writes a ByteWritable.
+func (e *Encoder) Int8(v int8) {
+	e.buf = append(e.buf, byte(v))
+}
+
+// Int16 writes a ShortWritable.
+func (e *Encoder) Int16(v int16) {
+	e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(v))
+}
+
+// Int32 writes an IntWritable.
+func (e *Encoder) Int32(v int32) {
+	e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(v))
+}
+
+// Int64 writes a LongWritable.
+func (e *Encoder) Int64(v int64) {
+	e.buf = binary.BigEndian.AppendUint64(e.buf, uint64(v))
+}
+
+// Float32 writes a FloatWritable.
+func (e *Encoder) Float32(v float32) {
+	e.Int32(int32(math.Float32bits(v)))
+}
+
+// Float64 writes a DoubleWritable.
+func (e *Encoder) Float64(v float64) {
+	e.Int64(int64(math.Float64bits(v)))
+}
+
+// VLong writes a variable-length integer, as read by
+// WritableUtils.readVLong.
+func (e *Encoder) VLong(v int64) {
+	if v >= -112 && v <= 127 {
+		e.buf = append(e.buf, byte(v))
+		return
+	}
+	prefix := int64(-112)
+	if v < 0 {
+		v = ^v
+		prefix = -120
+	}
+	n := 0
+	for tmp := v; tmp != 0; tmp >>= 8 {
+		n++
+	}
+	e.buf = append(e.buf, byte(prefix-int64(n)))
+	for i := n - 1; i >= 0; i-- {
+		e.buf = append(e.buf, byte(v>>(uint(i)*8)))
+	}
+}
+
+// Text writes a Text.
+func (e *Encoder) Text(v string) {
+	e.VLong(int64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+// Blob writes a BytesWritable.
+func (e *Encoder) Blob(v []byte) {
+	e.Len32(len(v))
+	e.buf = append(e.buf, v...)
+}
+
+// Len32 writes the length of an array, as read by ArrayWritable.
+func (e *Encoder) Len32(n int) {
+	e.Int32(int32(n))
+}
+
+// DecodeSlice reads an ArrayWritable, decoding each element with elem.
+// The slice grows as elements are read rather than being sized by the
+// declared length, so malformed input runs out of bytes before it can
+// exhaust memory.
+func DecodeSlice[T any](d *Decoder, elem func(d *Decoder) T) []T {
+	n := d.Len32()
+	c := n
+	if c > d.Len() {
+		c = d.Len()
+	}
+	res := make([]T, 0, c)
+	for i := 0; i < n; i++ {
+		res = append(res, elem(d))
+	}
+	return res
+}
+
+// EncodeSlice writes an ArrayWritable, encoding each element with elem.
+func EncodeSlice[T any](e *Encoder, v []T, elem func(e *Encoder, v T)) {
+	e.Len32(len(v))
+	for i := range v {
+		elem(e, v[i])
+	}
+}
@@ -0,0 +1,151 @@
+package bridge
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// codecTests holds values alongside their serialization as written by
+// the corresponding Hadoop Writable.
+var codecTests = []struct {
+	name   string
+	encode func(e *Encoder)
+	decode func(d *Decoder) interface{}
+	want   interface{}
+	bytes  []byte
+}{
+	{"BooleanWritable true", func(e *Encoder) { e.Bool(true) }, func(d *Decoder) interface{} { return d.Bool() }, true, []byte{0x01}},
+	{"BooleanWritable false", func(e *Encoder) { e.Bool(false) }, func(d *Decoder) interface{} { return d.Bool() }, false, []byte{0x00}},
+	{"ByteWritable -1", func(e *Encoder) { e.Int8(-1) }, func(d *Decoder) interface{} { return d.Int8() }, int8(-1), []byte{0xff}},
+	{"ShortWritable -2", func(e *Encoder) { e.Int16(-2) }, func(d *Decoder) interface{} { return d.Int16() }, int16(-2), []byte{0xff, 0xfe}},
+	{"IntWritable 258", func(e *Encoder) { e.Int32(258) }, func(d *Decoder) interface{} { return d.Int32() }, int32(258), []byte{0x00, 0x00, 0x01, 0x02}},
+	{"LongWritable min", func(e *Encoder) { e.Int64(math.MinInt64) }, func(d *Decoder) interface{} { return d.Int64() }, int64(math.MinInt64), []byte{0x80, 0, 0, 0, 0, 0, 0, 0}},
+	{"FloatWritable 1", func(e *Encoder) { e.Float32(1) }, func(d *Decoder) interface{} { return d.Float32() }, float32(1), []byte{0x3f, 0x80, 0x00, 0x00}},
+	{"DoubleWritable -2", func(e *Encoder) { e.Float64(-2) }, func(d *Decoder) interface{} { return d.Float64() }, float64(-2), []byte{0xc0, 0, 0, 0, 0, 0, 0, 0}},
+	{"Text empty", func(e *Encoder) { e.Text("") }, func(d *Decoder) interface{} { return d.Text() }, "", []byte{0x00}},
+	{"Text ascii", func(e *Encoder) { e.Text("hello") }, func(d *Decoder) interface{} { return d.Text() }, "hello", []byte{0x05, 'h', 'e', 'l', 'l', 'o'}},
+	{"Text utf-8", func(e *Encoder) { e.Text("é") }, func(d *Decoder) interface{} { return d.Text() }, "é", []byte{0x02, 0xc3, 0xa9}},
+	{"BytesWritable", func(e *Encoder) { e.Blob([]byte{1, 2, 3}) }, func(d *Decoder) interface{} { return d.Blob() }, []byte{1, 2, 3}, []byte{0, 0, 0, 3, 1, 2, 3}},
+	{"BytesWritable empty", func(e *Encoder) { e.Blob([]byte{}) }, func(d *Decoder) interface{} { return d.Blob() }, []byte{}, []byte{0, 0, 0, 0}},
+	{
+		"ArrayWritable of IntWritable",
+		func(e *Encoder) { EncodeSlice(e, []int32{1, -1}, (*Encoder).Int32) },
+		func(d *Decoder) interface{} { return DecodeSlice(d, (*Decoder).Int32) },
+		[]int32{1, -1},
+		[]byte{0, 0, 0, 2, 0, 0, 0, 1, 0xff, 0xff, 0xff, 0xff},
+	},
+	{
+		"ArrayWritable of Text",
+		func(e *Encoder) { EncodeSlice(e, []string{"a", "bc"}, (*Encoder).Text) },
+		func(d *Decoder) interface{} { return DecodeSlice(d, (*Decoder).Text) },
+		[]string{"a", "bc"},
+		[]byte{0, 0, 0, 2, 0x01, 'a', 0x02, 'b', 'c'},
+	},
+	{
+		"ArrayWritable empty",
+		func(e *Encoder) { EncodeSlice(e, []int64{}, (*Encoder).Int64) },
+		func(d *Decoder) interface{} { return DecodeSlice(d, (*Decoder).Int64) },
+		[]int64{},
+		[]byte{0, 0, 0, 0},
+	},
+}
+
+func TestEncoder(t *testing.T) {
+	for _, tt := range codecTests {
+		e := NewEncoder()
+		tt.encode(e)
+		if !bytes.Equal(e.Bytes(), tt.bytes) {
+			t.Errorf("%s: encoded % x, want % x", tt.name, e.Bytes(), tt.bytes)
+		}
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	for _, tt := range codecTests {
+		d := NewDecoder(tt.bytes)
+		if got := tt.decode(d); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: decoded %#v, want %#v", tt.name, got, tt.want)
+		}
+		if d.Len() != 0 {
+			t.Errorf("%s: %d bytes left unread", tt.name, d.Len())
+		}
+	}
+}
+
+// vlongTests holds values alongside their serialization as written by
+// WritableUtils.writeVLong. Values within the range of an int are
+// written identically by WritableUtils.writeVInt.
+var vlongTests = []struct {
+	v     int64
+	bytes []byte
+}{
+	{0, []byte{0x00}},
+	{1, []byte{0x01}},
+	{127, []byte{0x7f}},
+	{-1, []byte{0xff}},
+	{-112, []byte{0x90}},
+	{128, []byte{0x8f, 0x80}},
+	{255, []byte{0x8f, 0xff}},
+	{256, []byte{0x8e, 0x01, 0x00}},
+	{-113, []byte{0x87, 0x70}},
+	{-256, []byte{0x87, 0xff}},
+	{-257, []byte{0x86, 0x01, 0x00}},
+	{math.MaxInt32, []byte{0x8c, 0x7f, 0xff, 0xff, 0xff}},
+	{math.MinInt32, []byte{0x84, 0x7f, 0xff, 0xff, 0xff}},
+	{math.MaxInt64, []byte{0x88, 0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+	{math.MinInt64, []byte{0x80, 0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+}
+
+func TestVLong(t *testing.T) {
+	for _, tt := range vlongTests {
+		e := NewEncoder()
+		e.VLong(tt.v)
+		if !bytes.Equal(e.Bytes(), tt.bytes) {
+			t.Errorf("VLong(%d): encoded % x, want % x", tt.v, e.Bytes(), tt.bytes)
+		}
+		d := NewDecoder(tt.bytes)
+		if got := d.VLong(); got != tt.v || d.Len() != 0 {
+			t.Errorf("VLong(% x): decoded %d with %d bytes left, want %d", tt.bytes, got, d.Len(), tt.v)
+		}
+	}
+}
+
+func TestEncoderReset(t *testing.T) {
+	e := NewEncoder()
+	e.Text("discarded")
+	e.Reset()
+	e.Int8(1)
+	if want := []byte{0x01}; !bytes.Equal(e.Bytes(), want) {
+		t.Errorf("encoded % x after Reset, want % x", e.Bytes(), want)
+	}
+}
+
+// malformedTests holds input that a Decoder must reject.
+var malformedTests = []struct {
+	name   string
+	decode func(d *Decoder)
+	bytes  []byte
+}{
+	{"IntWritable truncated", func(d *Decoder) { d.Int32() }, []byte{0, 0, 1}},
+	{"Text truncated", func(d *Decoder) { d.Text() }, []byte{0x05, 'h', 'i'}},
+	{"Text negative length", func(d *Decoder) { d.Text() }, []byte{0xff}},
+	{"VLong truncated", func(d *Decoder) { d.VLong() }, []byte{0x8e, 0x01}},
+	{"BytesWritable negative length", func(d *Decoder) { d.Blob() }, []byte{0xff, 0xff, 0xff, 0xff}},
+	{"ArrayWritable truncated", func(d *Decoder) { DecodeSlice(d, (*Decoder).Int64) }, []byte{0, 0, 0, 1, 0}},
+	{"ArrayWritable huge length", func(d *Decoder) { DecodeSlice(d, (*Decoder).Int64) }, []byte{0x7f, 0xff, 0xff, 0xff, 0}},
+}
+
+func TestDecoderMalformed(t *testing.T) {
+	for _, tt := range malformedTests {
+		func() {
+			defer func() {
+				if _, ok := recover().(*DecodeError); !ok {
+					t.Errorf("%s: did not panic with a *DecodeError", tt.name)
+				}
+			}()
+			tt.decode(NewDecoder(tt.bytes))
+		}()
+	}
+}
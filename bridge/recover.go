@@ -0,0 +1,52 @@
+package bridge
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicPrefix prefixes the text of a PanicError.
+const PanicPrefix = "mrnative: panic: "
+
+// A PanicError is a panic recovered while processing a record.
+type PanicError struct {
+	Value interface{} // The value passed to panic.
+	Stack []byte      // The stack of the panicking goroutine.
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("%s%v\n\n%s", PanicPrefix, e.Value, e.Stack)
+}
+
+// Recover stops a panicking goroutine, storing the panic in err as a
+// *PanicError. It must be deferred directly by the function returning
+// err.
+func Recover(err *error) {
+	if r := recover(); r != nil {
+		*err = &PanicError{r, debug.Stack()}
+	}
+}
+
+// A Guard recovers panics like Recover, and records whether the last call
+// it guarded panicked. The generated Java asks the Guard, rather than
+// inspecting the text of an error, so an error returned by a target is
+// never mistaken for a panic.
+type Guard struct {
+	panicked bool
+}
+
+// Recover stops a panicking goroutine, storing the panic in err as a
+// *PanicError. It must be deferred directly by the function returning
+// err.
+func (g *Guard) Recover(err *error) {
+	r := recover()
+	g.panicked = r != nil
+	if r != nil {
+		*err = &PanicError{r, debug.Stack()}
+	}
+}
+
+// Panicked returns true if the last call guarded by g panicked.
+func (g *Guard) Panicked() bool {
+	return g.panicked
+}
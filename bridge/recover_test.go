@@ -0,0 +1,37 @@
+package bridge
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGuard(t *testing.T) {
+	var g Guard
+	call := func(fn func() error) (err error) {
+		defer g.Recover(&err)
+		return fn()
+	}
+	tests := []struct {
+		name     string
+		fn       func() error
+		panicked bool
+	}{
+		{"panic", func() error { panic("boom") }, true},
+		{"success", func() error { return nil }, false},
+		{"error with the panic prefix", func() error { return errors.New(PanicPrefix + "boom") }, false},
+		{"runtime error", func() error { var m map[string]int; m["x"] = 1; return nil }, true},
+	}
+	for _, tt := range tests {
+		err := call(tt.fn)
+		if g.Panicked() != tt.panicked {
+			t.Errorf("%s: Panicked() = %t, want %t", tt.name, g.Panicked(), tt.panicked)
+		}
+		if _, ok := err.(*PanicError); ok != tt.panicked {
+			t.Errorf("%s: got error %#v, want a *PanicError %t", tt.name, err, tt.panicked)
+		}
+		if tt.panicked && !strings.HasPrefix(err.Error(), PanicPrefix) {
+			t.Errorf("%s: got error %q, want prefix %q", tt.name, err, PanicPrefix)
+		}
+	}
+}
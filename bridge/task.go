@@ -0,0 +1,14 @@
+package bridge
+
+// A Task describes the task attempt a target is running in and, for a
+// mapper, its input split. It is set once per task by the generated Java,
+// so reading it does not cross into Java.
+type Task struct {
+	AttemptID string // The task attempt ID, such as "attempt_..._m_000000_0".
+	Path      string // The file of the input split, or "" if it is not a FileSplit.
+	Start     int    // The offset of the input split in Path.
+	Length    int    // The length of the input split, in bytes.
+
+	CacheFiles    []string // The local paths of the distributed cache files.
+	CacheArchives []string // The local paths of the distributed cache archives.
+}
@@ -0,0 +1,161 @@
+package mrnative
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A contextMethod describes a method the generator knows how to
+// implement on the Java side of a context interface, and the Hadoop
+// operation it is mapped to.
+type contextMethod struct {
+	op      string       // The Hadoop operation the method is mapped to.
+	targets []targetType // The target types the method is available to.
+	params  []string     // Expected parameter types, "*" matches any type.
+	returns []string     // Expected result types, "*" matches any type.
+	errors  bool         // Whether the method may also return an error.
+}
+
+// anyTarget lists every target type.
+var anyTarget = []targetType{targetMapper, targetReducer, targetCombiner}
+
+// reducers lists the target types Hadoop runs as a Reducer.
+var reducers = []targetType{targetReducer, targetCombiner}
+
+// contextMethods are the context interface methods recognized by the
+// generator, by Go method name.
+var contextMethods = map[string]contextMethod{
+	"Write":      {"TaskInputOutputContext.write", anyTarget, []string{"*", "*"}, nil, true},
+	"Counter":    {"TaskAttemptContext.getCounter", anyTarget, []string{"string", "string"}, []string{"*"}, false},
+	"Status":     {"TaskAttemptContext.getStatus", anyTarget, nil, []string{"string"}, false},
+	"SetStatus":  {"TaskAttemptContext.setStatus", anyTarget, []string{"string"}, nil, false},
+	"WriteNamed": {"MultipleOutputs.write", []targetType{targetMapper, targetReducer}, []string{"string", "*", "*"}, nil, true},
+	"Get":        {"Configuration.get", anyTarget, []string{"string"}, []string{"string"}, false},
+	"GetInt":     {"Configuration.getLong", anyTarget, []string{"string", "int"}, []string{"int"}, false},
+	"GetBool":    {"Configuration.getBoolean", anyTarget, []string{"string", "bool"}, []string{"bool"}, false},
+	"GetStrings": {"Configuration.getStrings", anyTarget, []string{"string"}, []string{"[]string"}, false},
+	"HasNext":    {"Iterator.hasNext", reducers, nil, []string{"bool"}, false},
+	"Next":       {"Iterator.next", reducers, nil, []string{"*"}, false},
+}
+
+// runMethods are the additional context methods recognized on the
+// context accepted by a Run method. They are implemented by the Go bridge,
+// which reads records from Java in batches.
+var runMethods = map[string]contextMethod{
+	"Scan":  {"Mapper.Context.nextKeyValue", []targetType{targetMapper}, nil, []string{"bool"}, false},
+	"Key":   {"Mapper.Context.getCurrentKey", []targetType{targetMapper}, nil, []string{"*"}, false},
+	"Value": {"Mapper.Context.getCurrentValue", []targetType{targetMapper}, nil, []string{"*"}, false},
+	"Err":   {"Mapper.Context.nextKeyValue", []targetType{targetMapper}, nil, []string{"error"}, false},
+}
+
+// taskMethods are the context methods describing the task attempt and
+// input split. They are implemented by the Go bridge from a Task set once
+// per task, rather than crossing into Java for each call.
+var taskMethods = map[string]contextMethod{
+	"InputPath":     {"FileSplit.getPath", []targetType{targetMapper}, nil, []string{"string"}, false},
+	"InputStart":    {"FileSplit.getStart", []targetType{targetMapper}, nil, []string{"int"}, false},
+	"InputLength":   {"InputSplit.getLength", []targetType{targetMapper}, nil, []string{"int"}, false},
+	"TaskAttemptID": {"TaskAttemptContext.getTaskAttemptID", anyTarget, nil, []string{"string"}, false},
+	"CacheFiles":    {"JobContext.getCacheFiles", anyTarget, nil, []string{"[]string"}, false},
+	"CacheArchives": {"JobContext.getCacheArchives", anyTarget, nil, []string{"[]string"}, false},
+}
+
+// counterMethods are the methods recognized on the interface returned
+// by a context's Counter method.
+var counterMethods = map[string]contextMethod{
+	"Value":     {"Counter.getValue", anyTarget, nil, []string{"int"}, false},
+	"SetValue":  {"Counter.setValue", anyTarget, []string{"int"}, nil, false},
+	"Increment": {"Counter.increment", anyTarget, []string{"int"}, nil, false},
+}
+
+// check returns a description of the problem if m cannot be mapped to
+// this operation by a Target of the given type.
+func (c contextMethod) check(typ targetType, m *Method) string {
+	available := false
+	for _, t := range c.targets {
+		if t == typ {
+			available = true
+		}
+	}
+	if !available {
+		return fmt.Sprintf("%s.%s is not available to a %s", m.recv, m.name, typ)
+	}
+	returns := matchParams(c.returns, m.returns)
+	if c.errors && !returns {
+		returns = matchParams(append(c.returns[:len(c.returns):len(c.returns)], "error"), m.returns)
+	}
+	if !matchParams(c.params, m.params) || !returns {
+		sig := c.signature(m.name, c.returns)
+		if c.errors {
+			sig += " or " + c.signature(m.name, append(c.returns[:len(c.returns):len(c.returns)], "error"))
+		}
+		return fmt.Sprintf("%s.%s must have signature %s to map to %s", m.recv, m.name, sig, c.op)
+	}
+	return ""
+}
+
+// signature returns the expected signature of the named method, with
+// the given results.
+func (c contextMethod) signature(name string, returns []string) string {
+	res := "func " + name + "(" + strings.Join(c.params, ", ") + ")"
+	switch len(returns) {
+	case 0:
+		return res
+	case 1:
+		return res + " " + returns[0]
+	}
+	return res + " (" + strings.Join(returns, ", ") + ")"
+}
+
+// matchParams returns true if params match the expected types.
+func matchParams(expected []string, params []*Param) bool {
+	if len(expected) != len(params) {
+		return false
+	}
+	for i, e := range expected {
+		if e != "*" && e != params[i].typ {
+			return false
+		}
+	}
+	return true
+}
+
+// checkContext ensures every method of the Target's context interface
+// has a Hadoop equivalent, locating the counter interface if necessary.
+func (t *Target) checkContext(errs *ErrorList) {
+	fail := func(m *Method, format string, args ...interface{}) {
+		errs.Add(ErrUnsupportedContextMethod, t.pkg.name, t.decl.name, t.pkg.position(m.pos), format, args...)
+	}
+	for _, m := range t.ctx.methods {
+		cm, ok := contextMethods[m.name]
+		if t.IsRunMethod(m.name) {
+			cm, ok = runMethods[m.name], true
+		} else if tm, isTask := taskMethods[m.name]; isTask {
+			cm, ok = tm, true
+		}
+		if !ok {
+			fail(m, "%s.%s has no Hadoop equivalent", m.recv, m.name)
+			continue
+		}
+		if problem := cm.check(t.typ, m); problem != "" {
+			fail(m, "%s", problem)
+			continue
+		}
+		if m.name != "Counter" {
+			continue
+		}
+		t.counter = t.pkg.lookupInterface(m.returns[0].t)
+		if t.counter == nil {
+			fail(m, "%s.%s must return an interface declared in package %s", m.recv, m.name, t.pkg.name)
+			continue
+		}
+		for _, cm := range t.counter.methods {
+			counterMethod, ok := counterMethods[cm.name]
+			if !ok {
+				fail(cm, "%s.%s has no Hadoop equivalent", cm.recv, cm.name)
+			} else if problem := counterMethod.check(t.typ, cm); problem != "" {
+				fail(cm, "%s", problem)
+			}
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package mrnative
+
+import (
+	"fmt"
+	"go/build"
+	"go/importer"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// loadTestPackage writes src to a package p in a temporary directory and
+// loads it.
+func loadTestPackage(t *testing.T, src string) *Package {
+	t.Helper()
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	bp := &build.Package{Dir: dir, Name: "p", ImportPath: "p", GoFiles: []string{"p.go"}}
+	fset := token.NewFileSet()
+	pkg, err := NewPackage(bp, fset, importer.ForCompiler(fset, "source", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pkg
+}
+
+// newTestTarget loads src and creates a Target of the given type from
+// the struct named name.
+func newTestTarget(t *testing.T, src, name string, typ targetType) (*Target, error) {
+	t.Helper()
+	pkg := loadTestPackage(t, src)
+	for _, s := range pkg.structs {
+		if s.name == name {
+			return NewTarget(pkg, s, typ)
+		}
+	}
+	t.Fatalf("struct %s not found", name)
+	return nil, nil
+}
+
+func TestMatchParams(t *testing.T) {
+	params := func(types ...string) []*Param {
+		var res []*Param
+		for _, typ := range types {
+			res = append(res, &Param{typ: typ})
+		}
+		return res
+	}
+	tests := []struct {
+		expected []string
+		params   []*Param
+		want     bool
+	}{
+		{nil, nil, true},
+		{[]string{"string"}, params("string"), true},
+		{[]string{"string"}, params("int"), false},
+		{[]string{"*", "*"}, params("string", "[]int"), true},
+		{[]string{"string", "*"}, params("int", "int"), false},
+		{[]string{"string"}, nil, false},
+		{nil, params("string"), false},
+		{[]string{"*"}, params("string", "int"), false},
+	}
+	for _, tt := range tests {
+		if got := matchParams(tt.expected, tt.params); got != tt.want {
+			var types []string
+			for _, p := range tt.params {
+				types = append(types, p.typ)
+			}
+			t.Errorf("matchParams(%q, %q) = %t, want %t", tt.expected, types, got, tt.want)
+		}
+	}
+}
+
+// contextTestSrc declares a mapper and reducer whose context interfaces
+// include the methods substituted for each %s.
+const contextTestSrc = `package p
+
+type Counter interface {
+	Increment(n int)
+}
+
+type BadCounter interface {
+	Reset()
+}
+
+type MapperContext interface {
+	Write(key string, val int)
+	%s
+}
+
+// @mapper
+type Mapper struct{}
+
+func NewMapper() *Mapper {
+	return &Mapper{}
+}
+
+func (m *Mapper) Map(key int, val string, ctx MapperContext) {}
+
+type ReducerContext interface {
+	Write(key string, val int)
+	HasNext() bool
+	Next() int
+	%s
+}
+
+// @reducer
+type Reducer struct{}
+
+func NewReducer() *Reducer {
+	return &Reducer{}
+}
+
+func (r *Reducer) Reduce(key string, ctx ReducerContext) {}
+`
+
+func TestCheckContext(t *testing.T) {
+	tests := []struct {
+		method string // A method added to the context interface.
+		typ    targetType
+		err    string // The expected message, or empty if valid.
+	}{
+		{"Status() string", targetMapper, ""},
+		{"Counter(group, name string) Counter", targetMapper, ""},
+		{"GetInt(key string, def int) int", targetReducer, ""},
+		{"InputPath() string", targetMapper, ""},
+		{"Flush()", targetMapper, "MapperContext.Flush has no Hadoop equivalent"},
+		{"Flush()", targetReducer, "ReducerContext.Flush has no Hadoop equivalent"},
+		{"Status() int", targetMapper, "MapperContext.Status must have signature func Status() string to map to TaskAttemptContext.getStatus"},
+		{"SetStatus(status []byte)", targetMapper, "MapperContext.SetStatus must have signature func SetStatus(string) to map to TaskAttemptContext.setStatus"},
+		{"GetInt(key string, def int32) int", targetReducer, "ReducerContext.GetInt must have signature func GetInt(string, int) int to map to Configuration.getLong"},
+		{"WriteNamed(name string, key string) error", targetMapper, "MapperContext.WriteNamed must have signature func WriteNamed(string, *, *) or func WriteNamed(string, *, *) error to map to MultipleOutputs.write"},
+		{"Next() int", targetMapper, "MapperContext.Next is not available to a Mapper"},
+		{"InputPath() string", targetReducer, "ReducerContext.InputPath is not available to a Reducer"},
+		{"Counter(group, name string) int", targetMapper, "MapperContext.Counter must return an interface declared in package p"},
+		{"Counter(group, name string) BadCounter", targetMapper, "BadCounter.Reset has no Hadoop equivalent"},
+	}
+	for _, tt := range tests {
+		mapperMethod, reducerMethod, name := tt.method, "", "Mapper"
+		if tt.typ == targetReducer {
+			mapperMethod, reducerMethod, name = "", tt.method, "Reducer"
+		}
+		_, err := newTestTarget(t, fmt.Sprintf(contextTestSrc, mapperMethod, reducerMethod), name, tt.typ)
+		if tt.err == "" {
+			if err != nil {
+				t.Errorf("%s on a %s: %s", tt.method, tt.typ, err)
+			}
+			continue
+		}
+		errs, ok := err.(ErrorList)
+		if !ok || len(errs) != 1 || errs[0].Kind != ErrUnsupportedContextMethod || errs[0].Msg != tt.err {
+			t.Errorf("%s on a %s: got error %v, want %q", tt.method, tt.typ, err, tt.err)
+		}
+	}
+}
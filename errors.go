@@ -0,0 +1,122 @@
+package mrnative
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+)
+
+// An ErrorKind describes what sort of problem an Error represents.
+type ErrorKind uint8
+
+const (
+	ErrPackage                  ErrorKind = iota // The package could not be loaded.
+	ErrNoTargets                                 // No annotated structs were found.
+	ErrMissingConstructor                        // The New<Struct> function is missing.
+	ErrMissingMethod                             // The Map or Reduce method is missing.
+	ErrInvalidSignature                          // A method has an unexpected signature.
+	ErrMissingContext                            // The context interface is missing.
+	ErrMissingContextMethod                      // The context lacks Write or Next.
+	ErrUnsupportedContextMethod                  // A context method has no Hadoop equivalent.
+	ErrUnsupportedType                           // A type has no Hadoop equivalent.
+	ErrRender                                    // Generated code could not be written.
+	ErrInvalidAnnotation                         // An annotation has invalid arguments.
+	ErrUndeclaredOutput                          // A named output is not declared.
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case ErrPackage:
+		return "package error"
+	case ErrNoTargets:
+		return "no targets"
+	case ErrMissingConstructor:
+		return "missing constructor"
+	case ErrMissingMethod:
+		return "missing method"
+	case ErrInvalidSignature:
+		return "invalid signature"
+	case ErrMissingContext:
+		return "missing context"
+	case ErrMissingContextMethod:
+		return "missing context method"
+	case ErrUnsupportedContextMethod:
+		return "unsupported context method"
+	case ErrUnsupportedType:
+		return "unsupported type"
+	case ErrRender:
+		return "render error"
+	case ErrInvalidAnnotation:
+		return "invalid annotation"
+	case ErrUndeclaredOutput:
+		return "undeclared output"
+	}
+	return "unknown error"
+}
+
+// An Error is a single problem encountered while analyzing a package
+// or generating code for a Target.
+type Error struct {
+	Kind    ErrorKind
+	Package string         // The package name, if known.
+	Struct  string         // The target struct name, if known.
+	Pos     token.Position // The source position, if known.
+	Msg     string
+}
+
+func (e *Error) Error() string {
+	var prefix []string
+	if e.Pos.IsValid() {
+		prefix = append(prefix, e.Pos.String())
+	}
+	if e.Package != "" && e.Struct != "" {
+		prefix = append(prefix, e.Package+"."+e.Struct)
+	} else if e.Package != "" {
+		prefix = append(prefix, e.Package)
+	}
+	prefix = append(prefix, e.Kind.String())
+	return strings.Join(prefix, ": ") + ": " + e.Msg
+}
+
+// An ErrorList is a list of Errors. The zero value is an empty
+// ErrorList, ready for use.
+type ErrorList []*Error
+
+// Add appends a new Error to the list.
+func (l *ErrorList) Add(kind ErrorKind, pkg, name string, pos token.Position, format string, args ...interface{}) {
+	*l = append(*l, &Error{kind, pkg, name, pos, fmt.Sprintf(format, args...)})
+}
+
+// Append appends the given error to the list, flattening any
+// ErrorList and wrapping any other error as an ErrPackage.
+func (l *ErrorList) Append(err error) {
+	switch err := err.(type) {
+	case nil:
+		return
+	case ErrorList:
+		*l = append(*l, err...)
+	case *Error:
+		*l = append(*l, err)
+	default:
+		*l = append(*l, &Error{Kind: ErrPackage, Msg: err.Error()})
+	}
+}
+
+func (l ErrorList) Error() string {
+	switch len(l) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", l[0], len(l)-1)
+}
+
+// Err returns an error equivalent to this list, or nil if the
+// list is empty.
+func (l ErrorList) Err() error {
+	if len(l) == 0 {
+		return nil
+	}
+	return l
+}
@@ -1,11 +1,13 @@
 package mrnative
 
 import (
-	"fmt"
-	"go/build"
-	"log"
+	"bytes"
+	"go/format"
+	"go/token"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/tyler-sommer/stick"
@@ -19,159 +21,421 @@ type Generator struct {
 	targets []*Target // Targets we are generating code for.
 }
 
-// NewGenerator creates a new Generator, ready for use.
-func NewGenerator(packages []string) *Generator {
+// NewGenerator creates a new Generator for the given packages, ready for
+// use.
+//
+// Packages may be given as import paths, relative directories, or
+// patterns such as "./...". They are resolved by the go command, so
+// modules and vendored dependencies are supported. If no packages are
+// given, the package in the current directory is used.
+//
+// If any package cannot be loaded or any target cannot be bridged, the
+// returned error is an ErrorList describing every problem found.
+func NewGenerator(packages []string) (*Generator, error) {
+	if len(packages) == 0 {
+		packages = []string{"."}
+	}
 	g := &Generator{newEnv(), []*Package{}, []*Target{}}
-	g.parsePackages(packages)
-	g.locateTargets()
-	return g
+	var errs ErrorList
+	// Targets are located in the packages that loaded, so their problems
+	// are reported along with those of the packages that did not.
+	errs.Append(g.parsePackages(packages))
+	errs.Append(g.locateTargets())
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return g, nil
 }
 
 func newEnv() *stick.Env {
 	env := stick.New(newTemplateLoader())
 	env.Filters["hadoop_type"] = func(e stick.Context, val stick.Value, args ...stick.Value) stick.Value {
+		if t, ok := val.(*Type); ok {
+			return t.Hadoop()
+		}
 		return GoToHadoopType(stick.CoerceString(val))
 	}
-	env.Filters["valuein_type"] = func(e stick.Context, val stick.Value, args ...stick.Value) stick.Value {
-		return GoToValueInHadoopType(stick.CoerceString(val))
-	}
 	env.Filters["java_type"] = func(e stick.Context, val stick.Value, args ...stick.Value) stick.Value {
+		if t, ok := val.(*Type); ok {
+			return t.Java()
+		}
 		return GoToJavaType(stick.CoerceString(val))
 	}
-	env.Filters["transform"] = func(e stick.Context, val stick.Value, args ...stick.Value) stick.Value {
-		baseTyp := stick.CoerceString(val)
-		passedVar := stick.CoerceString(args[0])
-		transformedVar := stick.CoerceString(args[1])
-		getMethod := ".get();"
-		if baseTyp == "string" {
-			getMethod = ".toString();"
-		}
-		if strings.HasPrefix(baseTyp, "[]") {
-			// Go actually wants a slice. We gotta make magic baby!
-			return ""
-		}
-
-		return fmt.Sprintf("%s %s = %s%s", GoToJavaType(baseTyp), transformedVar, passedVar, getMethod)
+	env.Filters["unwrap"] = func(e stick.Context, val stick.Value, args ...stick.Value) stick.Value {
+		return val.(*Type).Unwrap(stick.CoerceString(args[0]))
+	}
+	env.Filters["wrap"] = func(e stick.Context, val stick.Value, args ...stick.Value) stick.Value {
+		return val.(*Type).Wrap(stick.CoerceString(args[0]))
+	}
+	env.Filters["unwrap_args"] = func(e stick.Context, val stick.Value, args ...stick.Value) stick.Value {
+		return val.(*Type).Args(stick.CoerceString(args[0]))
+	}
+	env.Filters["bind_params"] = func(e stick.Context, val stick.Value, args ...stick.Value) stick.Value {
+		return val.(*Type).Params(stick.CoerceString(args[0]))
+	}
+	env.Filters["to_go_params"] = func(e stick.Context, val stick.Value, args ...stick.Value) stick.Value {
+		return val.(*Type).ToGoParams(stick.CoerceString(args[0]))
+	}
+	env.Filters["bind_type"] = func(e stick.Context, val stick.Value, args ...stick.Value) stick.Value {
+		return val.(*Type).Bind()
+	}
+	env.Filters["to_go"] = func(e stick.Context, val stick.Value, args ...stick.Value) stick.Value {
+		return val.(*Type).ToGo(stick.CoerceString(args[0]))
+	}
+	env.Filters["from_go"] = func(e stick.Context, val stick.Value, args ...stick.Value) stick.Value {
+		return val.(*Type).FromGo(stick.CoerceString(args[0]))
 	}
 	return env
 }
 
-func (g *Generator) parsePackages(packages []string) {
-	for _, path := range packages {
-		absPath := path
-		if !build.IsLocalImport(path) {
-			absPath = prefixPath(path)
-		}
-		if _, err := os.Stat(absPath); os.IsNotExist(err) {
-			log.Fatalln("parsing package:", err)
-		}
-		var p *build.Package
-		var err error
-		if !isDirectory(absPath) {
-			path = filepath.Dir(path)
-			absPath = filepath.Dir(path)
-		}
-		p, err = build.ImportDir(absPath, 0)
+// parsePackages loads the given packages. Packages that cannot be loaded
+// are reported in the returned ErrorList; the rest are still added to
+// the Generator.
+func (g *Generator) parsePackages(packages []string) error {
+	list, err := listPackages(packages)
+	if list == nil {
+		return err
+	}
+	var errs ErrorList
+	errs.Append(err)
+	fset := token.NewFileSet()
+	for _, lp := range list.pkgs {
+		pkg, err := NewPackage(lp.buildPackage(), fset, list.importer(fset, lp))
 		if err != nil {
-			log.Fatalln("importing dir:", err)
+			errs.Append(err)
+			continue
 		}
-		g.pkgs = append(g.pkgs, NewPackage(absPath, p))
+		g.pkgs = append(g.pkgs, pkg)
 	}
+	return errs.Err()
 }
 
-func (g *Generator) Generate() {
+// Generate generates code for each Target.
+//
+// For each package containing targets, a Go bridge is written to the
+// package directory, adapting each target to types gobind supports. Java
+// sources are written to build/java/go.
+func (g *Generator) Generate() error {
 	if len(g.targets) == 0 {
-		log.Fatalln("no targets found.")
+		return &Error{Kind: ErrNoTargets, Msg: "no targets found"}
+	}
+	var errs ErrorList
+	for _, pkg := range g.pkgs {
+		targets := g.packageTargets(pkg)
+		if len(targets) == 0 {
+			continue
+		}
+		errs.Append(g.genBridge(pkg, targets))
+		errs.Append(g.genSupport(pkg, targets))
 	}
 	for _, target := range g.targets {
-		g.genJava(target)
+		errs.Append(g.genJava(target))
+	}
+	return errs.Err()
+}
+
+// packageTargets returns the Targets declared in the given Package.
+func (g *Generator) packageTargets(pkg *Package) []*Target {
+	var res []*Target
+	for _, t := range g.targets {
+		if t.pkg == pkg {
+			res = append(res, t)
+		}
 	}
+	return res
+}
+
+// gobindClassRoot returns the name of the Java class gobind generates
+// for the given Package.
+func gobindClassRoot(pkg *Package) string {
+	return strings.ToTitle(string(pkg.name[0])) + pkg.name[1:]
 }
 
 func tplParams(t *Target) map[string]stick.Value {
-	gobindClassRoot := strings.ToTitle(string(t.pkg.name[0])) + t.pkg.name[1:]
+	gobindClassRoot := gobindClassRoot(t.pkg)
 	var mapredMethodName string
 	var mapredClassName string
-	if t.IsMapper() {
+	switch {
+	case t.IsMapper():
 		mapredMethodName = "map"
 		mapredClassName = "Mapper"
-	} else {
+	case t.IsPartitioner():
+		mapredMethodName = "getPartition"
+		mapredClassName = "Partitioner"
+	case t.IsGrouping():
+		mapredMethodName = "compare"
+		mapredClassName = "WritableComparator"
+	default:
 		mapredMethodName = "reduce"
 		mapredClassName = "Reducer"
 	}
+	var goCtxInterface string
+	if t.ctx != nil {
+		goCtxInterface = t.ctx.name
+	}
+	var hooks []*Method
+	for _, h := range []*Method{t.setup, t.cleanup} {
+		if h != nil {
+			hooks = append(hooks, h)
+		}
+	}
+	goBridge := t.decl.name + "Bridge"
 	return map[string]stick.Value{
 		"target": t,
+		"hooks":  hooks,
 
-		"goStructName":   t.decl.name,
-		"goCtxInterface": t.ctx.name,
+		"goStructName":    t.decl.name,
+		"goCtxInterface":  goCtxInterface,
+		"goCtorType":      t.ctor.returns[0].typ,
+		"goBridge":        goBridge,
+		"goBridgeCtx":     goBridge + "Context",
+		"goBridgeCtxImpl": strings.ToLower(t.decl.name[:1]) + t.decl.name[1:] + "Context",
+		"goBridgeRunImpl": strings.ToLower(t.decl.name[:1]) + t.decl.name[1:] + "RunContext",
 
 		"javaPackage":   "go." + t.pkg.name,
 		"javaClassName": gobindClassRoot + t.decl.name,
 
 		"gobindClassRoot":   gobindClassRoot,
-		"gobindCtxClass":    gobindClassRoot + "." + t.ctx.name,
-		"gobindClass":       gobindClassRoot + "." + t.decl.name,
-		"gobindConstructor": gobindClassRoot + "." + t.ctor.name,
+		"gobindCtxClass":    gobindClassRoot + "." + goBridge + "Context",
+		"gobindClass":       gobindClassRoot + "." + goBridge,
+		"gobindConstructor": gobindClassRoot + ".New" + goBridge,
 		"gobindMethodName":  t.method.name,
 
 		"mapredMethodName": mapredMethodName,
 		"mapredClassName":  mapredClassName,
 
-		"keyIn":    t.keyIn.typ,
-		"valueIn":  t.valueIn.typ,
-		"keyOut":   t.keyOut.typ,
-		"valueOut": t.valueOut.typ,
+		"keyIn":    t.keyIn,
+		"valueIn":  t.valueIn,
+		"keyOut":   t.keyOut,
+		"valueOut": t.valueOut,
+
+		"namedKey":   t.namedKey,
+		"namedValue": t.namedValue,
 	}
 }
 
-func (g *Generator) genJava(target *Target) {
+// bridgeFileName is the name of the Go bridge written to each package.
+const bridgeFileName = "mrnative_bridge.go"
+
+// bridgeImportPath is the import path of the package supporting the Go
+// bridge.
+const bridgeImportPath = "github.com/veonik/go-mrnative/bridge"
+
+// genBridge writes the Go bridge for the given targets to the package
+// directory.
+func (g *Generator) genBridge(pkg *Package, targets []*Target) error {
+	var tparams []map[string]stick.Value
+	var codecs []*Type
+	imports := []string{bridgeImportPath}
+	seen := make(map[string]bool)
+	for _, t := range targets {
+		tparams = append(tparams, tplParams(t))
+		for _, typ := range t.Types() {
+			imports = append(imports, typ.Imports(pkg.types)...)
+			if !typ.IsScalar() && !seen[typ.name] {
+				seen[typ.name] = true
+				codecs = append(codecs, typ)
+			}
+		}
+	}
+	params := map[string]stick.Value{
+		"name":    pkg.name,
+		"targets": tparams,
+		"codecs":  codecs,
+		"imports": uniqueSorted(imports),
+	}
+	var buf bytes.Buffer
+	if err := g.env.Execute("tpl/bridge_template.go.twig", &buf, params); err != nil {
+		return renderError(pkg, err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return renderError(pkg, err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(pkg.dir, bridgeFileName), src, 0644); err != nil {
+		return renderError(pkg, err)
+	}
+	return nil
+}
+
+// uniqueSorted returns the distinct strings in s, sorted.
+func uniqueSorted(s []string) []string {
+	seen := make(map[string]bool)
+	var res []string
+	for _, v := range s {
+		if !seen[v] {
+			seen[v] = true
+			res = append(res, v)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// genSupport writes the Java classes shared by the given targets.
+func (g *Generator) genSupport(pkg *Package, targets []*Target) error {
+	params := map[string]stick.Value{
+		"javaPackage": "go." + pkg.name,
+	}
+	if err := g.render(pkg, "tpl/bridge_template.java.twig", "MrnativeBridge", params); err != nil {
+		return err
+	}
+	keys := make(map[string]bool)
+	for _, t := range targets {
+		for _, typ := range t.KeyTypes() {
+			keys[typ.Hadoop()] = true
+		}
+	}
+	seen := make(map[string]bool)
+	for _, t := range targets {
+		for _, typ := range t.Types() {
+			if typ.IsScalar() || seen[typ.Hadoop()] {
+				continue
+			}
+			seen[typ.Hadoop()] = true
+			params := map[string]stick.Value{
+				"javaPackage":   "go." + pkg.name,
+				"javaClassName": typ.Hadoop(),
+				"type":          typ,
+			}
+			tpl := "tpl/struct_template.java.twig"
+			if typ.IsSlice() {
+				tpl = "tpl/array_template.java.twig"
+				params["elem"] = typ.elem
+			} else {
+				params["comparable"] = keys[typ.Hadoop()]
+			}
+			if err := g.render(pkg, tpl, typ.Hadoop(), params); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (g *Generator) genJava(target *Target) error {
 	params := tplParams(target)
-	dir, _ := os.Readlink(target.pkg.dir)
+	tpl := "tpl/class_template.java.twig"
+	if target.IsPartitioner() {
+		tpl = "tpl/partitioner_template.java.twig"
+	} else if target.IsGrouping() {
+		tpl = "tpl/grouping_template.java.twig"
+	}
+	err := g.render(target.pkg, tpl, params["javaClassName"].(string), params)
+	if err, ok := err.(*Error); ok {
+		err.Struct = target.decl.name
+	}
+	return err
+}
+
+// render renders the named template to the Java source file for the
+// given class.
+func (g *Generator) render(pkg *Package, tpl, className string, params map[string]stick.Value) error {
+	dir, _ := os.Readlink(pkg.dir)
 	dir = filepath.Join(dir, "build/java/go")
 	if err := os.MkdirAll(dir, os.ModeDir|os.ModePerm); err != nil {
-		log.Fatalf("rendering: %s", err.Error())
+		return renderError(pkg, err)
 	}
-	f, err := os.Create(filepath.Join(dir, params["javaClassName"].(string)+".java"))
+	f, err := os.Create(filepath.Join(dir, className+".java"))
 	if err != nil {
-		log.Fatalf("rendering: %s", err.Error())
+		return renderError(pkg, err)
 	}
-	err = g.env.Execute("tpl/class_template.java.twig", f, params)
-	if err != nil {
-		log.Fatalf("rendering: %s", err.Error())
+	defer f.Close()
+	if err := g.env.Execute(tpl, f, params); err != nil {
+		return renderError(pkg, err)
 	}
+	return nil
+}
+
+// renderError returns an Error describing a failure to generate code for
+// the given Package.
+func renderError(pkg *Package, err error) error {
+	return &Error{Kind: ErrRender, Package: pkg.name, Msg: err.Error()}
+}
+
+// annotations maps each target annotation to the type of target it
+// declares.
+var annotations = []struct {
+	name string
+	typ  targetType
+}{
+	{"@mapper", targetMapper},
+	{"@reducer", targetReducer},
+	{"@partitioner", targetPartitioner},
+	{"@combiner", targetCombiner},
+	{"@grouping", targetGrouping},
 }
 
-func (g *Generator) locateTargets() {
+func (g *Generator) locateTargets() error {
+	var errs ErrorList
 	for _, pkg := range g.pkgs {
 		for _, s := range pkg.structs {
-			if strings.Contains(s.comment, "@mapper") {
-				g.targets = append(g.targets, NewTarget(pkg, s, targetMapper))
+			var found []string
+			typ := targetMapper
+			for _, a := range annotations {
+				if len(s.annotations(a.name)) > 0 {
+					found = append(found, strings.TrimPrefix(a.name, "@"))
+					typ = a.typ
+				}
 			}
-			if strings.Contains(s.comment, "@reducer") {
-				g.targets = append(g.targets, NewTarget(pkg, s, targetReducer))
+			switch {
+			case len(found) == 0:
+			case len(found) == 1:
+				g.addTarget(pkg, s, typ, &errs)
+			case len(found) == 2 && found[0] == "reducer" && found[1] == "combiner":
+				// A reducer may also serve as the combiner.
+				g.addTarget(pkg, s, targetReducer, &errs)
+			default:
+				errs.Add(ErrInvalidSignature, pkg.name, s.name, pkg.position(s.pos), "a struct cannot be both a %s", strings.Join(found, " and a "))
 			}
 		}
 	}
+	g.checkMapOutputs(&errs)
+	return errs.Err()
 }
 
-// isDirectory returns true if name is a directory.
-func isDirectory(name string) bool {
-	info, err := os.Stat(name)
-	if err != nil {
-		log.Fatalln("checking is directory:", err)
+// checkMapOutputs ensures the input key and value types of each
+// partitioner and combiner, and the key type of each grouping comparator,
+// match the output types of a mapper in the same package, if the package
+// declares any mappers. Mappers in other packages are not considered,
+// as types are only compared by their names within a package.
+func (g *Generator) checkMapOutputs(errs *ErrorList) {
+	for _, p := range g.targets {
+		if !p.IsPartitioner() && !p.IsCombiner() && !p.IsGrouping() {
+			continue
+		}
+		var outputs []string
+		match := false
+		for _, m := range g.packageTargets(p.pkg) {
+			if !m.IsMapper() {
+				continue
+			}
+			if p.IsGrouping() {
+				outputs = append(outputs, m.keyOut.name)
+				match = match || m.keyOut.name == p.keyIn.name
+			} else {
+				outputs = append(outputs, "("+m.keyOut.name+", "+m.valueOut.name+")")
+				match = match || m.keyOut.name == p.keyIn.name && m.valueOut.name == p.valueIn.name
+			}
+		}
+		if len(outputs) == 0 || match {
+			continue
+		}
+		if p.IsGrouping() {
+			errs.Add(ErrInvalidSignature, p.pkg.name, p.decl.name, p.pkg.position(p.method.pos), "key type %s must match the output key type of a mapper, found %s", p.keyIn.name, strings.Join(outputs, ", "))
+		} else {
+			errs.Add(ErrInvalidSignature, p.pkg.name, p.decl.name, p.pkg.position(p.method.pos), "key and value types (%s, %s) must match the output types of a mapper, found %s", p.keyIn.name, p.valueIn.name, strings.Join(outputs, ", "))
+		}
 	}
-	return info.IsDir()
 }
 
-// prefixPath prefixes the given path with GOROOT and GOPATH
-// in an attempt to locate the actual full path.
-func prefixPath(path string) string {
-	res := filepath.Join(build.Default.GOROOT, "src", path)
-	if _, err := os.Stat(res); os.IsNotExist(err) {
-		res = filepath.Join(build.Default.GOPATH, "src", path)
-		if _, err := os.Stat(res); os.IsNotExist(err) {
-			log.Fatalln("prefixing path: %s", err)
-		}
+// addTarget creates a new Target, appending it to the Generator's targets
+// or its problems to errs.
+func (g *Generator) addTarget(pkg *Package, s *Struct, typ targetType, errs *ErrorList) {
+	t, err := NewTarget(pkg, s, typ)
+	if err != nil {
+		errs.Append(err)
+		return
 	}
-	return res
+	g.targets = append(g.targets, t)
 }
@@ -0,0 +1,135 @@
+package mrnative
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const staleMapper = `package wc
+
+type MapperContext interface {
+	Write(key string, val int)
+}
+
+// @mapper
+type Mapper struct{}
+
+func NewMapper() *Mapper {
+	return &Mapper{}
+}
+
+func (m *Mapper) Map(key int, val %s, ctx MapperContext) {
+	ctx.Write("", 1)
+}
+`
+
+const staleModule = `module example.com/stale
+
+go 1.20
+
+require github.com/veonik/go-mrnative v0.0.0
+
+replace github.com/veonik/go-mrnative => %s
+`
+
+// writeModule writes a module containing the package wc, whose Mapper
+// accepts values of the given type, to dir. The module uses the copy of
+// go-mrnative found at root, so the generated bridge compiles.
+func writeModule(t *testing.T, dir, root, valueType string) {
+	t.Helper()
+	writeFiles(t, dir, map[string]string{
+		"go.mod":   strings.Replace(staleModule, "%s", root, 1),
+		"wc/wc.go": strings.Replace(staleMapper, "%s", valueType, 1),
+	})
+}
+
+// writeFiles writes each of the given files, by path relative to dir.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, src := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// generate loads the packages in the current directory and generates
+// code for them.
+func generate(t *testing.T) {
+	t.Helper()
+	g, err := NewGenerator([]string{"./..."})
+	if err != nil {
+		t.Fatalf("NewGenerator: %s", err)
+	}
+	if err := g.Generate(); err != nil {
+		t.Fatalf("Generate: %s", err)
+	}
+}
+
+// chdir changes the working directory to dir until the test completes,
+// returning the previous working directory.
+func chdir(t *testing.T, dir string) string {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+	return wd
+}
+
+func TestNewGeneratorStaleBridge(t *testing.T) {
+	dir := t.TempDir()
+	wd := chdir(t, dir)
+
+	writeModule(t, dir, wd, "string")
+	generate(t)
+
+	// The bridge written above no longer compiles once the signature of
+	// Map changes.
+	writeModule(t, dir, wd, "int32")
+	generate(t)
+
+	src, err := ioutil.ReadFile(filepath.Join(dir, "wc", bridgeFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(src), "val int32") {
+		t.Errorf("bridge was not regenerated for the new signature:\n%s", src)
+	}
+}
+
+func TestNewGeneratorReportsEveryPackage(t *testing.T) {
+	dir := t.TempDir()
+	chdir(t, dir)
+	writeFiles(t, dir, map[string]string{
+		"go.mod":       "module example.com/errs\n\ngo 1.20\n",
+		"broken/b.go":  "package broken\n\nvar x int = \"x\"\n",
+		"target/t.go":  "package target\n\n// @mapper\ntype Mapper struct{}\n",
+		"healthy/h.go": "package healthy\n",
+	})
+	_, err := NewGenerator([]string{"./..."})
+	errs, ok := err.(ErrorList)
+	if !ok {
+		t.Fatalf("NewGenerator: got %v, want an ErrorList", err)
+	}
+	kinds := make(map[ErrorKind]bool)
+	for _, e := range errs {
+		kinds[e.Kind] = true
+	}
+	for _, k := range []ErrorKind{ErrPackage, ErrMissingConstructor} {
+		if !kinds[k] {
+			t.Errorf("NewGenerator: no %s reported in %v", k, errs)
+		}
+	}
+}
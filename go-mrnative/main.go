@@ -34,8 +34,9 @@ Commands:
 
   build [<package> [, <package> , ... ] ]
 	Generates Java source, compiles and jars it. This command accepts zero
-	or more package names, which, if passed, will be included in the final
-	jar. If no packages are passed, %s will use the current directory.
+	or more package names or patterns, such as "./...", which, if passed,
+	will be included in the final jar. If no packages are passed, %s
+	will use the current directory.
 `, name, name, name)
 }
 
@@ -89,6 +90,23 @@ func initCmd() {
 }
 
 func generateCmd(pkgs []string) {
-	g := mrnative.NewGenerator(pkgs)
-	g.Generate()
+	g, err := mrnative.NewGenerator(pkgs)
+	if err == nil {
+		err = g.Generate()
+	}
+	if err != nil {
+		fatal(err)
+	}
+}
+
+// fatal logs each error contained in err and exits.
+func fatal(err error) {
+	if errs, ok := err.(mrnative.ErrorList); ok {
+		for _, e := range errs {
+			log.Println(e)
+		}
+	} else {
+		log.Println(err)
+	}
+	os.Exit(1)
 }
@@ -0,0 +1,5 @@
+module github.com/veonik/go-mrnative
+
+go 1.19
+
+require github.com/tyler-sommer/stick v1.0.0
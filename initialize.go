@@ -35,6 +35,7 @@ func (i *Initializer) CollectConfig(out io.Writer, in io.Reader) {
 		panic(err)
 	}
 	i.pieces["name"] = c.AskDefault("Enter package name", filepath.Base(d))
+	i.pieces["writeErrors"] = c.AskDefaultBool("Return Write errors to Go?", false)
 	types := make(map[string]stick.Value)
 	mapper := false
 	reducer := false
@@ -0,0 +1,199 @@
+package mrnative
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/build"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// listedPackage is the subset of the output of "go list -json" that
+// is necessary to load a package.
+type listedPackage struct {
+	Dir        string
+	ImportPath string
+	Name       string
+	GoFiles    []string
+	CgoFiles   []string
+	Export     string
+	DepOnly    bool
+	ImportMap  map[string]string
+	Error      *struct {
+		Pos string
+		Err string
+	}
+}
+
+// A packageList is the result of resolving a set of package patterns.
+type packageList struct {
+	pkgs    []*listedPackage // Packages matched by the patterns.
+	exports map[string]string
+}
+
+// listPackages resolves the given patterns to packages using the go
+// command. The go command understands modules, including replace
+// directives and vendored dependencies, as well as GOPATH.
+//
+// In addition to the matched packages, export data is built for each
+// of their dependencies so the packages can be type checked. Bridges
+// written by an earlier build are left out, as they may no longer
+// compile against the packages they adapt.
+//
+// If some packages have errors, those that do not are returned along
+// with an ErrorList describing the errors.
+func listPackages(patterns []string) (*packageList, error) {
+	args := []string{"--"}
+	for _, p := range patterns {
+		args = append(args, localPattern(p))
+	}
+	overlay, err := bridgeOverlay(args)
+	if err != nil {
+		return nil, err
+	}
+	if overlay != "" {
+		defer os.Remove(overlay)
+		args = append([]string{"-overlay", overlay}, args...)
+	}
+	listed, err := goList(append([]string{"-export", "-deps"}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	res := &packageList{exports: make(map[string]string)}
+	var errs ErrorList
+	for _, lp := range listed {
+		if lp.Export != "" {
+			res.exports[lp.ImportPath] = lp.Export
+		}
+		if lp.DepOnly {
+			continue
+		}
+		if lp.Error != nil {
+			errs.Add(ErrPackage, lp.ImportPath, "", parsePosition(lp.Error.Pos), "%s", lp.Error.Err)
+			continue
+		}
+		res.pkgs = append(res.pkgs, lp)
+	}
+	return res, errs.Err()
+}
+
+// bridgeOverlay writes an overlay file for the go command hiding the
+// bridge in each package matched by args. It returns the path to the
+// overlay file, or an empty string if no package contains a bridge.
+func bridgeOverlay(args []string) (string, error) {
+	listed, err := goList(args...)
+	if err != nil {
+		return "", err
+	}
+	replace := make(map[string]string)
+	for _, lp := range listed {
+		path := filepath.Join(lp.Dir, bridgeFileName)
+		if _, err := os.Stat(path); err == nil {
+			replace[path] = ""
+		}
+	}
+	if len(replace) == 0 {
+		return "", nil
+	}
+	f, err := ioutil.TempFile("", "mrnative-overlay-*.json")
+	if err != nil {
+		return "", &Error{Kind: ErrPackage, Msg: fmt.Sprintf("go list: %s", err)}
+	}
+	defer f.Close()
+	if err := json.NewEncoder(f).Encode(struct{ Replace map[string]string }{replace}); err != nil {
+		os.Remove(f.Name())
+		return "", &Error{Kind: ErrPackage, Msg: fmt.Sprintf("go list: %s", err)}
+	}
+	return f.Name(), nil
+}
+
+// goList runs "go list -e -json" with the given arguments and decodes
+// the listed packages.
+func goList(args ...string) ([]*listedPackage, error) {
+	args = append([]string{"list", "-e", "-json"}, args...)
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, &Error{Kind: ErrPackage, Msg: fmt.Sprintf("go list: %s: %s", err, strings.TrimSpace(stderr.String()))}
+	}
+	var res []*listedPackage
+	dec := json.NewDecoder(&stdout)
+	for {
+		lp := &listedPackage{}
+		if err := dec.Decode(lp); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, &Error{Kind: ErrPackage, Msg: fmt.Sprintf("go list: %s", err)}
+		}
+		res = append(res, lp)
+	}
+	return res, nil
+}
+
+// buildPackage returns the build.Package equivalent to lp.
+func (lp *listedPackage) buildPackage() *build.Package {
+	return &build.Package{
+		Dir:        lp.Dir,
+		Name:       lp.Name,
+		ImportPath: lp.ImportPath,
+		GoFiles:    lp.GoFiles,
+		CgoFiles:   lp.CgoFiles,
+	}
+}
+
+// importer returns a types.Importer that reads the export data built for
+// the dependencies of lp.
+func (l *packageList) importer(fset *token.FileSet, lp *listedPackage) types.Importer {
+	return importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+		if mapped, ok := lp.ImportMap[path]; ok {
+			path = mapped
+		}
+		file, ok := l.exports[path]
+		if !ok {
+			return nil, fmt.Errorf("no export data for %s", path)
+		}
+		return os.Open(file)
+	})
+}
+
+// localPattern converts a path to a single Go file into the path to the
+// directory containing it.
+func localPattern(pattern string) string {
+	if !strings.HasSuffix(pattern, ".go") {
+		return pattern
+	}
+	if info, err := os.Stat(pattern); err != nil || info.IsDir() {
+		return pattern
+	}
+	dir := filepath.Dir(pattern)
+	if !filepath.IsAbs(dir) && !build.IsLocalImport(dir) {
+		dir = "." + string(filepath.Separator) + dir
+	}
+	return dir
+}
+
+// parsePosition parses a position as formatted by the go command,
+// "file:line:column".
+func parsePosition(pos string) token.Position {
+	var res token.Position
+	parts := strings.Split(pos, ":")
+	if len(parts) < 2 {
+		return res
+	}
+	res.Filename = parts[0]
+	fmt.Sscan(parts[1], &res.Line)
+	if len(parts) > 2 {
+		fmt.Sscan(parts[2], &res.Column)
+	}
+	return res
+}
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"go/ast"
 	"go/build"
-	"go/importer"
+	"go/constant"
 	"go/parser"
 	"go/token"
 	"go/types"
@@ -16,6 +16,8 @@ import (
 type Struct struct {
 	name    string
 	comment string
+	pos     token.Pos
+	typ     *types.Named
 	methods []*Method
 }
 
@@ -23,162 +25,207 @@ func (s *Struct) String() string {
 	return fmt.Sprintf("%s{%s}", s.name, s.comment)
 }
 
-func NewStruct(tdef structDecl, methods []*Method) *Struct {
-	var cmt []string
-	if tdef.decl.Doc != nil {
-		declComments := tdef.decl.Doc.List
-		for i := 0; i < len(declComments); i++ {
-			cmt = append(cmt, declComments[i].Text)
-		}
-	}
-	if tdef.spec.Doc != nil {
-		specComments := tdef.spec.Doc.List
-		for i := 0; i < len(specComments); i++ {
-			cmt = append(cmt, specComments[i].Text)
+// NewStruct creates a new Struct from the given type declaration. The
+// Struct's methods are those in the method set of a pointer to the type,
+// including methods with value receivers and promoted methods.
+func NewStruct(obj *types.TypeName, comment string, qf types.Qualifier) *Struct {
+	named := obj.Type().(*types.Named)
+	var m []*Method
+	mset := types.NewMethodSet(types.NewPointer(named))
+	for i := 0; i < mset.Len(); i++ {
+		if fn, ok := mset.At(i).Obj().(*types.Func); ok {
+			m = append(m, NewMethod(obj.Name(), fn, qf))
 		}
 	}
-	return &Struct{tdef.spec.Name.String(), strings.Join(cmt, "\n"), methods}
+	return &Struct{obj.Name(), comment, obj.Pos(), named, m}
 }
 
 type Interface struct {
 	name    string
+	pos     token.Pos
+	typ     *types.Named
 	methods []*Method
 }
 
-func NewInterface(spec *ast.TypeSpec) *Interface {
-	name := spec.Name.String()
-	typ := spec.Type.(*ast.InterfaceType)
+// NewInterface creates a new Interface from the given type declaration.
+// The Interface's methods are its full method set, including methods of
+// embedded interfaces declared in this or any other package.
+func NewInterface(obj *types.TypeName, qf types.Qualifier) *Interface {
+	named := obj.Type().(*types.Named)
+	typ := named.Underlying().(*types.Interface)
 	var m []*Method
-	methods := typ.Methods.List
-	for i := 0; i < len(methods); i++ {
-		switch methods[i].Type.(type) {
-		case *ast.FuncType:
-			m = append(m, NewInterfaceMethod(name, methods[i]))
-
-		case *ast.Ident:
-		// Handle embedded interface
+	for i := 0; i < typ.NumMethods(); i++ {
+		m = append(m, NewMethod(obj.Name(), typ.Method(i), qf))
+	}
+	return &Interface{obj.Name(), obj.Pos(), named, m}
+}
 
-		default:
-			// ...?
-		}
+// Name returns the name of the Interface.
+func (i *Interface) Name() string {
+	return i.name
+}
 
-	}
-	return &Interface{name, m}
+// Methods returns the method set of the Interface, sorted by name.
+func (i *Interface) Methods() []*Method {
+	return i.methods
 }
 
-type Method struct {
-	*Func
-	recv string
+// HasMethod returns true if the Interface has a method with the given name.
+func (i *Interface) HasMethod(name string) bool {
+	return i.method(name) != nil
 }
 
-func NewInterfaceMethod(recv string, f *ast.Field) *Method {
-	var p []*Param
-	var r []*Param
-	if typ, ok := f.Type.(*ast.FuncType); ok {
-		if par := typ.Params; par != nil {
-			params := par.List
-			for i := 0; i < len(params); i++ {
-				p = append(p, NewParam(params[i]))
-			}
-		}
-		if ret := typ.Results; ret != nil {
-			returns := ret.List
-			for i := 0; i < len(returns); i++ {
-				r = append(r, NewParam(returns[i]))
-			}
+// method returns the method with the given name, or nil if there is none.
+func (i *Interface) method(name string) *Method {
+	for _, m := range i.methods {
+		if m.name == name {
+			return m
 		}
 	}
+	return nil
+}
 
-	return &Method{&Func{f.Names[0].Name, p, r}, recv}
+type Method struct {
+	*Func
+	recv string
 }
 
-func NewMethod(fn *ast.FuncDecl) *Method {
-	return &Method{NewFunc(fn), fmt.Sprintf("%+v", fn.Recv.List[0].Type.(*ast.StarExpr).X)}
+// NewMethod creates a new Method declared on the named type recv.
+func NewMethod(recv string, fn *types.Func, qf types.Qualifier) *Method {
+	return &Method{NewFunc(fn, qf), recv}
 }
 
 type Func struct {
 	name    string
+	pos     token.Pos
 	params  []*Param
 	returns []*Param
 }
 
-func NewFunc(fn *ast.FuncDecl) *Func {
-	var p []*Param
-	var r []*Param
-	if par := fn.Type.Params; par != nil {
-		params := par.List
-		for i := 0; i < len(params); i++ {
-			p = append(p, NewParam(params[i]))
-		}
+// NewFunc creates a new Func from the given function or method.
+func NewFunc(fn *types.Func, qf types.Qualifier) *Func {
+	sig := fn.Type().(*types.Signature)
+	return &Func{fn.Name(), fn.Pos(), newParams(sig.Params(), qf), newParams(sig.Results(), qf)}
+}
+
+// Name returns the name of the Func.
+func (f *Func) Name() string {
+	return f.name
+}
+
+// ReturnsError returns true if the Func returns a single error.
+func (f *Func) ReturnsError() bool {
+	return len(f.returns) == 1 && isError(f.returns[0].t)
+}
+
+// Signature returns the Func's name and signature as they would be
+// declared in an interface.
+func (f *Func) Signature() string {
+	params := make([]string, len(f.params))
+	for i, p := range f.params {
+		params[i] = strings.TrimSpace(p.name + " " + p.typ)
 	}
-	if ret := fn.Type.Results; ret != nil {
-		returns := ret.List
-		for i := 0; i < len(returns); i++ {
-			r = append(r, NewParam(returns[i]))
-		}
+	res := f.name + "(" + strings.Join(params, ", ") + ")"
+	returns := make([]string, len(f.returns))
+	for i, p := range f.returns {
+		returns[i] = strings.TrimSpace(p.name + " " + p.typ)
+	}
+	switch {
+	case len(returns) == 0:
+		return res
+	case len(returns) == 1 && f.returns[0].name == "":
+		return res + " " + returns[0]
 	}
-	return &Func{fn.Name.String(), p, r}
+	return res + " (" + strings.Join(returns, ", ") + ")"
 }
 
 type Param struct {
 	name string
-	typ  string
+	typ  string // The type as it would be spelled in the declaring package.
+	t    types.Type
 }
 
-func NewParam(param *ast.Field) *Param {
-	var typName string
-	if typ, ok := param.Type.(*ast.ArrayType); ok {
-		typName = "[]" + fmt.Sprintf("%+v", typ.Elt)
-	} else {
-		typName = fmt.Sprintf("%+v", param.Type)
-	}
-	var name string
-	if param.Names == nil {
-		name = ""
-	} else {
-		name = param.Names[0].Name
+// NewParam creates a new Param from the given parameter or result.
+func NewParam(v *types.Var, qf types.Qualifier) *Param {
+	return &Param{v.Name(), types.TypeString(v.Type(), qf), v.Type()}
+}
+
+// newParams creates a Param for every variable in the given tuple.
+func newParams(vars *types.Tuple, qf types.Qualifier) []*Param {
+	var res []*Param
+	for i := 0; i < vars.Len(); i++ {
+		res = append(res, NewParam(vars.At(i), qf))
 	}
-	return &Param{name, typName}
+	return res
 }
 
 // A Package contains information about a given Package.
 type Package struct {
 	name       string
+	path       string // The import path.
 	dir        string
+	fset       *token.FileSet
+	types      *types.Package
+	info       *types.Info
 	structs    []*Struct
 	interfaces []*Interface
 	functions  []*Func
 
-	gend bool // True if this package already contains generated Go code.
-}
+	namedWrites []*namedWrite // Calls to WriteNamed with a constant name.
 
-type structDecl struct {
-	decl *ast.GenDecl
-	spec *ast.TypeSpec
+	gend bool // True if this package already contains generated Go code.
 }
 
-// NewPackage creates a new package, ready for use.
-func NewPackage(absPath string, p *build.Package) *Package {
-	pkg := &Package{p.Name, absPath, []*Struct{}, []*Interface{}, []*Func{}, false}
+// NewPackage creates a new package, ready for use. Files are parsed into
+// fset and imports are resolved using imp.
+func NewPackage(p *build.Package, fset *token.FileSet, imp types.Importer) (*Package, error) {
+	pkg := &Package{
+		name:       p.Name,
+		path:       p.ImportPath,
+		dir:        p.Dir,
+		fset:       fset,
+		structs:    []*Struct{},
+		interfaces: []*Interface{},
+		functions:  []*Func{},
+	}
 
-	fs := token.NewFileSet()
 	var astFiles []*ast.File
-	for _, filename := range p.GoFiles {
-		filename = filepath.Join(absPath, filename)
-		parsedFile, err := parser.ParseFile(fs, filename, nil, parser.ParseComments)
+	for _, filename := range append(p.GoFiles, p.CgoFiles...) {
+		if filename == bridgeFileName {
+			// Generated code is replaced, not analyzed.
+			pkg.gend = true
+			continue
+		}
+		filename = filepath.Join(p.Dir, filename)
+		parsedFile, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
 		if err != nil {
-			log.Fatalf("parsing package: %s: %s", filename, err)
+			return nil, &Error{Kind: ErrPackage, Package: p.Name, Msg: err.Error()}
 		}
 		astFiles = append(astFiles, parsedFile)
 	}
-	pkg.check(fs, astFiles)
+	if err := pkg.check(astFiles, imp); err != nil {
+		return nil, err
+	}
 	pkg.walkFiles(astFiles)
-	return pkg
+	return pkg, nil
 }
 
+// position returns the full source position of the given pos.
+func (pkg *Package) position(pos token.Pos) token.Position {
+	return pkg.fset.Position(pos)
+}
+
+// qualifier returns the package name of any package other than pkg,
+// so that types are spelled as they would be in pkg's source.
+func (pkg *Package) qualifier(other *types.Package) string {
+	if other == pkg.types {
+		return ""
+	}
+	return other.Name()
+}
+
+// walkFiles creates the Package members from the type-checked files.
 func (pkg *Package) walkFiles(files []*ast.File) {
-	var structs []structDecl
-	var methods []*ast.FuncDecl
 	for h := 0; h < len(files); h++ {
 		file := files[h]
 		for i := 0; i < len(file.Decls); i++ {
@@ -189,22 +236,30 @@ func (pkg *Package) walkFiles(files []*ast.File) {
 					if !ok {
 						continue
 					}
-					switch spec.Type.(type) {
-					case *ast.InterfaceType:
-						pkg.interfaces = append(pkg.interfaces, NewInterface(spec))
+					obj, ok := pkg.info.Defs[spec.Name].(*types.TypeName)
+					if !ok || obj.IsAlias() {
+						continue
+					}
+					switch obj.Type().Underlying().(type) {
+					case *types.Interface:
+						pkg.interfaces = append(pkg.interfaces, NewInterface(obj, pkg.qualifier))
 						log.Printf("%s: type %s interface\n", file.Name, spec.Name)
-					case *ast.StructType:
-						structs = append(structs, structDecl{decl, spec})
+					case *types.Struct:
+						pkg.structs = append(pkg.structs, NewStruct(obj, declComment(decl, spec), pkg.qualifier))
 						log.Printf("%s: type %s struct\n", file.Name, spec.Name)
 					}
 				}
 			case *ast.FuncDecl:
+				fn, ok := pkg.info.Defs[decl.Name].(*types.Func)
+				if !ok {
+					continue
+				}
 				if decl.Recv == nil {
-					pkg.functions = append(pkg.functions, NewFunc(decl))
+					pkg.functions = append(pkg.functions, NewFunc(fn, pkg.qualifier))
 					log.Printf("%s: func %s(...)\n", file.Name, decl.Name)
 				} else {
-					methods = append(methods, decl)
-					log.Printf("%s: func (%s) %s(...)\n", file.Name, decl.Recv.List[0].Type, decl.Name)
+					pkg.findNamedWrites(fn, decl)
+					log.Printf("%s: func (%s) %s(...)\n", file.Name, types.TypeString(fn.Type().(*types.Signature).Recv().Type(), pkg.qualifier), decl.Name)
 				}
 
 			default:
@@ -212,27 +267,105 @@ func (pkg *Package) walkFiles(files []*ast.File) {
 			}
 		}
 	}
-	// After all Package members are known, we can create our Structs.
-	for i := 0; i < len(structs); i++ {
-		var sm []*Method
-		name := structs[i].spec.Name.String()
-		for j := 0; j < len(methods); j++ {
-			fn := methods[j]
-			recv := fmt.Sprintf("%+v", fn.Recv.List[0].Type.(*ast.StarExpr).X)
-			if recv == name {
-				sm = append(sm, NewMethod(fn))
-			}
+}
+
+// A namedWrite is a call to a context's WriteNamed method, within a method
+// of a struct in the package.
+type namedWrite struct {
+	recv string // The name of the struct whose method makes the call.
+	name string // The constant output name passed.
+	pos  token.Pos
+}
+
+// findNamedWrites records each call to a WriteNamed method with a
+// constant output name in the body of the given method.
+func (pkg *Package) findNamedWrites(fn *types.Func, decl *ast.FuncDecl) {
+	recv := fn.Type().(*types.Signature).Recv().Type()
+	if ptr, ok := recv.(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+	named, ok := recv.(*types.Named)
+	if !ok || decl.Body == nil {
+		return
+	}
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "WriteNamed" {
+			return true
+		}
+		if _, ok := pkg.info.Uses[sel.Sel].(*types.Func); !ok {
+			return true
+		}
+		if v := pkg.info.Types[call.Args[0]].Value; v != nil && v.Kind() == constant.String {
+			pkg.namedWrites = append(pkg.namedWrites, &namedWrite{named.Obj().Name(), constant.StringVal(v), call.Pos()})
+		}
+		return true
+	})
+}
+
+// lookupInterface returns the Interface declared in this Package with
+// the given type, or nil if there is none.
+func (pkg *Package) lookupInterface(t types.Type) *Interface {
+	for _, i := range pkg.interfaces {
+		if types.Identical(i.typ, t) {
+			return i
+		}
+	}
+	return nil
+}
+
+// annotations returns the arguments of each line of the Struct's doc
+// comment beginning with the given annotation, such as "@cachefile".
+func (s *Struct) annotations(name string) [][]string {
+	var res [][]string
+	for _, line := range strings.Split(s.comment, "\n") {
+		fields := strings.Fields(strings.TrimPrefix(line, "//"))
+		if len(fields) > 0 && fields[0] == name {
+			res = append(res, fields[1:])
 		}
-		pkg.structs = append(pkg.structs, NewStruct(structs[i], sm))
 	}
-	fmt.Println(pkg.structs)
+	return res
 }
 
-// check ensures the package has no parse errors.
-func (pkg *Package) check(fs *token.FileSet, astFiles []*ast.File) {
-	config := types.Config{Importer: importer.Default(), FakeImportC: true}
-	_, err := config.Check(pkg.dir, fs, astFiles, nil)
-	if err != nil {
-		log.Fatalf("checking package: %s", err)
+// declComment returns the doc comments attached to the given type
+// declaration.
+func declComment(decl *ast.GenDecl, spec *ast.TypeSpec) string {
+	var cmt []string
+	for _, doc := range []*ast.CommentGroup{decl.Doc, spec.Doc} {
+		if doc == nil {
+			continue
+		}
+		for _, c := range doc.List {
+			cmt = append(cmt, c.Text)
+		}
+	}
+	return strings.Join(cmt, "\n")
+}
+
+// check ensures the package has no type errors. Every type error is
+// reported, not just the first.
+func (pkg *Package) check(astFiles []*ast.File, imp types.Importer) error {
+	var errs ErrorList
+	config := types.Config{
+		Importer:    imp,
+		FakeImportC: true,
+		Error: func(err error) {
+			if terr, ok := err.(types.Error); ok {
+				errs.Add(ErrPackage, pkg.name, "", terr.Fset.Position(terr.Pos), "%s", terr.Msg)
+			} else {
+				errs.Append(err)
+			}
+		},
+	}
+	pkg.info = &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Defs:  make(map[*ast.Ident]types.Object),
+		Uses:  make(map[*ast.Ident]types.Object),
 	}
+	pkg.types, _ = config.Check(pkg.path, pkg.fset, astFiles, pkg.info)
+	return errs.Err()
 }
@@ -1,6 +1,11 @@
 package mrnative
 
-import "log"
+import (
+	"go/token"
+	"go/types"
+	"net/url"
+	"strings"
+)
 
 // A targetType defines what type of target a struct is.
 type targetType uint8
@@ -8,6 +13,9 @@ type targetType uint8
 const (
 	targetMapper targetType = iota
 	targetReducer
+	targetPartitioner
+	targetCombiner
+	targetGrouping
 )
 
 func (t targetType) String() string {
@@ -16,6 +24,12 @@ func (t targetType) String() string {
 		return "Mapper"
 	case targetReducer:
 		return "Reducer"
+	case targetPartitioner:
+		return "Partitioner"
+	case targetCombiner:
+		return "Combiner"
+	case targetGrouping:
+		return "Grouping"
 	}
 	return "Unknown"
 }
@@ -25,22 +39,47 @@ type Target struct {
 	typ targetType
 	pkg *Package
 
-	decl   *Struct
-	ctor   *Func
-	method *Method
-	ctx    *Interface
+	decl    *Struct
+	ctor    *Func
+	method  *Method
+	ctx     *Interface
+	counter *Interface // The interface returned by ctx.Counter, if any.
+	setup   *Method    // The Setup method, if any.
+	cleanup *Method    // The Cleanup method, if any.
+
+	combiner     bool // Whether the target is also the job's combiner.
+	run          bool // Whether the target pulls records itself through Run.
+	returnsError bool // Whether the Map, Reduce or Run method returns an error.
+	writeErrors  bool // Whether ctx.Write returns an error.
+
+	cacheFiles    []string // URIs declared with @cachefile.
+	cacheArchives []string // URIs declared with @cachearchive.
+	namedOutputs  []string // Names declared with @namedoutput.
+
+	namedWriteErrors bool // Whether ctx.WriteNamed returns an error.
 
-	keyIn    *Param
-	valueIn  *Param
-	keyOut   *Param
-	valueOut *Param
+	keyIn    *Type
+	valueIn  *Type
+	keyOut   *Type
+	valueOut *Type
+
+	namedKey   *Type // The key type of ctx.WriteNamed, if any.
+	namedValue *Type // The value type of ctx.WriteNamed, if any.
 }
 
-func NewTarget(pkg *Package, decl *Struct, typ targetType) *Target {
+// NewTarget creates a new Target for the given struct. If the struct
+// cannot be bridged, the returned error is an ErrorList describing every
+// problem found.
+func NewTarget(pkg *Package, decl *Struct, typ targetType) (*Target, error) {
 	tgt := &Target{
-		typ:  typ,
-		pkg:  pkg,
-		decl: decl,
+		typ:      typ,
+		pkg:      pkg,
+		decl:     decl,
+		combiner: typ == targetCombiner || typ == targetReducer && len(decl.annotations("@combiner")) > 0,
+	}
+	var errs ErrorList
+	fail := func(kind ErrorKind, pos token.Pos, format string, args ...interface{}) {
+		errs.Add(kind, pkg.name, decl.name, pkg.position(pos), format, args...)
 	}
 	ctorName := "New" + decl.name
 	for _, fn := range pkg.functions {
@@ -50,56 +89,442 @@ func NewTarget(pkg *Package, decl *Struct, typ targetType) *Target {
 		}
 	}
 	if tgt.ctor == nil {
-		log.Fatalf("Unable to locate constructor function %s.%s", pkg.name, ctorName)
+		fail(ErrMissingConstructor, decl.pos, "unable to locate constructor function %s.%s", pkg.name, ctorName)
+	} else if !isConstructor(tgt.ctor, decl) {
+		fail(ErrInvalidSignature, tgt.ctor.pos, "%s must accept no parameters and return %s or *%s", ctorName, decl.name, decl.name)
+	}
+	if typ == targetPartitioner || typ == targetGrouping {
+		if typ == targetPartitioner {
+			tgt.checkPartition(&errs)
+		} else {
+			tgt.checkCompare(&errs)
+		}
+		if len(errs) > 0 {
+			return nil, errs
+		}
+		return tgt, nil
 	}
 	var methName string
+	nparams := 3
 	if typ == targetMapper {
 		methName = "Map"
 	} else {
 		methName = "Reduce"
+		nparams = 2
 	}
+	var run *Method
 	for _, m := range decl.methods {
-		if m.name == methName {
+		switch m.name {
+		case "Run":
+			run = m
+		case methName:
 			tgt.method = m
-			// TODO: Ensure params
 		}
 	}
+	if run != nil {
+		if typ != targetMapper {
+			fail(ErrInvalidSignature, run.pos, "\"Run\" is only supported on a mapper, a %s must declare \"%s\"", typ, methName)
+			return nil, errs
+		}
+		if tgt.method != nil {
+			fail(ErrInvalidSignature, run.pos, "struct %s.%s declares both \"Map\" and \"Run\", only one may be declared", pkg.name, decl.name)
+			return nil, errs
+		}
+		tgt.method = run
+		tgt.run = true
+		methName = "Run"
+		nparams = 1
+	}
 	if tgt.method == nil {
-		log.Fatalf("Unable to locate \"%s\" method on struct %s.%s", methName, pkg.name, decl.name)
+		fail(ErrMissingMethod, decl.pos, "unable to locate \"%s\" method on struct %s.%s", methName, pkg.name, decl.name)
+		return nil, errs
 	}
-	ctxParam := tgt.method.params[len(tgt.method.params)-1]
-	for _, i := range pkg.interfaces {
-		if i.name == ctxParam.typ {
-			tgt.ctx = i
-		}
+	if len(tgt.method.params) != nparams {
+		fail(ErrInvalidSignature, tgt.method.pos, "\"%s\" must accept %d parameters, found %d", methName, nparams, len(tgt.method.params))
+		return nil, errs
 	}
+	switch {
+	case len(tgt.method.returns) == 0:
+	case tgt.method.ReturnsError():
+		tgt.returnsError = true
+	default:
+		fail(ErrInvalidSignature, tgt.method.pos, "\"%s\" must return nothing or an error", methName)
+	}
+	ctxParam := tgt.method.params[len(tgt.method.params)-1]
+	tgt.ctx = pkg.lookupInterface(ctxParam.t)
 	if tgt.ctx == nil {
-		log.Fatalf("Unable to locate interface %s.%s", pkg.name, ctxParam.typ)
+		fail(ErrMissingContext, tgt.method.pos, "context %s must be an interface declared in package %s", ctxParam.typ, pkg.name)
+		return nil, errs
 	}
-	var ctxWrite *Method
-	var ctxNext *Method
-	for _, m := range tgt.ctx.methods {
-		if m.name == "Write" {
-			ctxWrite = m
-		} else if typ == targetReducer && m.name == "Next" {
-			ctxNext = m
+	tgt.checkContext(&errs)
+	tgt.cacheFiles = tgt.cacheURIs("@cachefile", &errs)
+	tgt.cacheArchives = tgt.cacheURIs("@cachearchive", &errs)
+	tgt.namedOutputs = tgt.outputNames(&errs)
+	tgt.setup = tgt.hook("Setup", ctxParam.t, &errs)
+	tgt.cleanup = tgt.hook("Cleanup", ctxParam.t, &errs)
+	ctxWrite := tgt.ctx.method("Write")
+	var ctxNext, ctxKey, ctxValue *Method
+	if tgt.IsReducer() {
+		ctxNext = tgt.ctx.method("Next")
+	}
+	if tgt.run {
+		for _, name := range []string{"Scan", "Key", "Value"} {
+			if tgt.ctx.method(name) == nil {
+				fail(ErrMissingContextMethod, tgt.ctx.pos, "unable to locate \"%s\" method on interface %s.%s", name, pkg.name, tgt.ctx.name)
+			}
+		}
+		ctxKey = tgt.ctx.method("Key")
+		if ctxKey != nil && len(ctxKey.returns) != 1 {
+			ctxKey = nil // Reported by checkContext.
+		}
+		ctxValue = tgt.ctx.method("Value")
+		if ctxValue != nil && len(ctxValue.returns) != 1 {
+			ctxValue = nil // Reported by checkContext.
 		}
 	}
 	if ctxWrite == nil {
-		log.Fatalf("Unable to locate \"Write\" method on interface %s.%s", pkg.name, tgt.ctx.name)
+		fail(ErrMissingContextMethod, tgt.ctx.pos, "unable to locate \"Write\" method on interface %s.%s", pkg.name, tgt.ctx.name)
+	} else if len(ctxWrite.params) != 2 {
+		ctxWrite = nil // Reported by checkContext.
+	} else {
+		tgt.writeErrors = len(ctxWrite.returns) == 1
 	}
-	if typ == targetReducer && ctxNext == nil {
-		log.Fatalf("Unable to locate \"Next\" method on interface %s.%s", pkg.name, tgt.ctx.name)
+	if tgt.IsReducer() {
+		if ctxNext == nil {
+			fail(ErrMissingContextMethod, tgt.ctx.pos, "unable to locate \"Next\" method on interface %s.%s", pkg.name, tgt.ctx.name)
+		} else if len(ctxNext.returns) != 1 {
+			ctxNext = nil // Reported by checkContext.
+		}
+	}
+	resolve := func(p *Param, pos token.Pos) *Type {
+		t, err := NewType(p.t, pkg.qualifier)
+		if err != nil {
+			fail(ErrUnsupportedType, pos, "%s", err)
+		}
+		return t
 	}
-	tgt.keyIn = tgt.method.params[0]
-	if typ == targetReducer {
-		tgt.valueIn = ctxNext.returns[0]
+	if tgt.run {
+		if ctxKey != nil {
+			tgt.keyIn = resolve(ctxKey.returns[0], ctxKey.pos)
+		}
+		if ctxValue != nil {
+			tgt.valueIn = resolve(ctxValue.returns[0], ctxValue.pos)
+		}
 	} else {
-		tgt.valueIn = tgt.method.params[1]
+		tgt.keyIn = resolve(tgt.method.params[0], tgt.method.pos)
+	}
+	if tgt.IsReducer() {
+		if ctxNext != nil {
+			tgt.valueIn = resolve(ctxNext.returns[0], ctxNext.pos)
+		}
+	} else if !tgt.run {
+		tgt.valueIn = resolve(tgt.method.params[1], tgt.method.pos)
+	}
+	if ctxWrite != nil {
+		tgt.keyOut = resolve(ctxWrite.params[0], ctxWrite.pos)
+		tgt.valueOut = resolve(ctxWrite.params[1], ctxWrite.pos)
+	}
+	if m := tgt.ctx.method("WriteNamed"); m != nil && len(m.params) == 3 {
+		tgt.namedKey = resolve(m.params[1], m.pos)
+		tgt.namedValue = resolve(m.params[2], m.pos)
+		tgt.namedWriteErrors = len(m.returns) == 1
+		for _, w := range pkg.namedWrites {
+			if w.recv == decl.name && !tgt.HasNamedOutput(w.name) {
+				errs.Add(ErrUndeclaredOutput, pkg.name, decl.name, pkg.position(w.pos), "output %q must be declared with @namedoutput", w.name)
+			}
+		}
+		if tgt.combiner {
+			fail(ErrUnsupportedContextMethod, m.pos, "%s.WriteNamed is not available to a Combiner", tgt.ctx.name)
+		}
+	} else if len(tgt.namedOutputs) > 0 {
+		fail(ErrInvalidAnnotation, decl.pos, "@namedoutput requires a WriteNamed method on interface %s.%s", pkg.name, tgt.ctx.name)
+	}
+	if tgt.combiner && tgt.keyIn != nil && tgt.valueIn != nil && tgt.keyOut != nil && tgt.valueOut != nil {
+		if tgt.keyIn.name != tgt.keyOut.name || tgt.valueIn.name != tgt.valueOut.name {
+			fail(ErrInvalidSignature, tgt.method.pos, "a combiner's input types (%s, %s) must equal its output types (%s, %s)", tgt.keyIn.name, tgt.valueIn.name, tgt.keyOut.name, tgt.valueOut.name)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return tgt, nil
+}
+
+// checkPartition locates the Partition method of a partitioner, resolving
+// its key and value types.
+func (t *Target) checkPartition(errs *ErrorList) {
+	fail := func(kind ErrorKind, pos token.Pos, format string, args ...interface{}) {
+		errs.Add(kind, t.pkg.name, t.decl.name, t.pkg.position(pos), format, args...)
+	}
+	for _, m := range t.decl.methods {
+		if m.name == "Partition" {
+			t.method = m
+		}
+	}
+	if t.method == nil {
+		fail(ErrMissingMethod, t.decl.pos, "unable to locate \"Partition\" method on struct %s.%s", t.pkg.name, t.decl.name)
+		return
+	}
+	m := t.method
+	isInt := func(p *Param) bool { return types.Identical(p.t, types.Typ[types.Int]) }
+	if len(m.params) != 3 || !isInt(m.params[2]) || len(m.returns) != 1 || !isInt(m.returns[0]) {
+		fail(ErrInvalidSignature, m.pos, "\"Partition\" must have signature func(key K, val V, numPartitions int) int")
+		return
+	}
+	for i, dst := range []**Type{&t.keyIn, &t.valueIn} {
+		typ, err := NewType(m.params[i].t, t.pkg.qualifier)
+		if err != nil {
+			fail(ErrUnsupportedType, m.pos, "%s", err)
+		}
+		*dst = typ
+	}
+}
+
+// checkCompare locates the Compare method of a grouping comparator,
+// resolving its key type.
+func (t *Target) checkCompare(errs *ErrorList) {
+	fail := func(kind ErrorKind, pos token.Pos, format string, args ...interface{}) {
+		errs.Add(kind, t.pkg.name, t.decl.name, t.pkg.position(pos), format, args...)
+	}
+	for _, m := range t.decl.methods {
+		if m.name == "Compare" {
+			t.method = m
+		}
+	}
+	if t.method == nil {
+		fail(ErrMissingMethod, t.decl.pos, "unable to locate \"Compare\" method on struct %s.%s", t.pkg.name, t.decl.name)
+		return
+	}
+	m := t.method
+	if len(m.params) != 2 || !types.Identical(m.params[0].t, m.params[1].t) || len(m.returns) != 1 || !types.Identical(m.returns[0].t, types.Typ[types.Int]) {
+		fail(ErrInvalidSignature, m.pos, "\"Compare\" must have signature func(a, b K) int")
+		return
+	}
+	typ, err := NewType(m.params[0].t, t.pkg.qualifier)
+	if err != nil {
+		fail(ErrUnsupportedType, m.pos, "%s", err)
+	}
+	t.keyIn = typ
+}
+
+// isConstructor returns true if fn accepts no parameters and returns a
+// single value of type decl or *decl.
+func isConstructor(fn *Func, decl *Struct) bool {
+	if len(fn.params) != 0 || len(fn.returns) != 1 {
+		return false
+	}
+	t := fn.returns[0].t
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	return types.Identical(t, decl.typ)
+}
+
+// hook returns the named lifecycle method of the Target's struct, or nil
+// if there is none. A method with an unexpected signature is reported to
+// errs.
+func (t *Target) hook(name string, ctx types.Type, errs *ErrorList) *Method {
+	for _, m := range t.decl.methods {
+		if m.name != name {
+			continue
+		}
+		if len(m.params) != 1 || !types.Identical(m.params[0].t, ctx) || (len(m.returns) != 0 && !m.ReturnsError()) {
+			errs.Add(ErrInvalidSignature, t.pkg.name, t.decl.name, t.pkg.position(m.pos), "\"%s\" must accept a single %s and return nothing or an error", name, t.ctx.name)
+			return nil
+		}
+		return m
+	}
+	return nil
+}
+
+// cacheURIs returns the URIs declared by the named annotation on the
+// Target's struct. An annotation without a single valid URI is reported
+// to errs.
+func (t *Target) cacheURIs(name string, errs *ErrorList) []string {
+	var res []string
+	for _, args := range t.decl.annotations(name) {
+		if len(args) != 1 {
+			errs.Add(ErrInvalidAnnotation, t.pkg.name, t.decl.name, t.pkg.position(t.decl.pos), "%s must be followed by a single URI", name)
+			continue
+		}
+		if _, err := url.Parse(args[0]); err != nil {
+			errs.Add(ErrInvalidAnnotation, t.pkg.name, t.decl.name, t.pkg.position(t.decl.pos), "%s: %s", name, err)
+			continue
+		}
+		if strings.ContainsAny(args[0], "\"\\") {
+			errs.Add(ErrInvalidAnnotation, t.pkg.name, t.decl.name, t.pkg.position(t.decl.pos), "%s: URI %s must not contain quotes or backslashes", name, args[0])
+			continue
+		}
+		res = append(res, args[0])
+	}
+	return res
+}
+
+// outputNames returns the output names declared by @namedoutput on the
+// Target's struct. Hadoop requires names to be alphanumeric, and reserves
+// "part". An invalid name is reported to errs.
+func (t *Target) outputNames(errs *ErrorList) []string {
+	var res []string
+	for _, args := range t.decl.annotations("@namedoutput") {
+		if len(args) != 1 {
+			errs.Add(ErrInvalidAnnotation, t.pkg.name, t.decl.name, t.pkg.position(t.decl.pos), "@namedoutput must be followed by a single name")
+			continue
+		}
+		name := args[0]
+		valid := name != "part"
+		for _, r := range name {
+			if !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9') {
+				valid = false
+			}
+		}
+		if !valid {
+			errs.Add(ErrInvalidAnnotation, t.pkg.name, t.decl.name, t.pkg.position(t.decl.pos), "@namedoutput: %q must be alphanumeric and not \"part\"", name)
+			continue
+		}
+		res = append(res, name)
 	}
-	tgt.keyOut = ctxWrite.params[0]
-	tgt.valueOut = ctxWrite.params[1]
-	return tgt
+	return res
+}
+
+// isError returns true if t is the predeclared error type.
+func isError(t types.Type) bool {
+	return types.Identical(t, types.Universe.Lookup("error").Type())
+}
+
+// Types returns the distinct key and value types of the Target, including
+// the types of slice elements and struct fields.
+func (t *Target) Types() []*Type {
+	var res []*Type
+	seen := make(map[string]bool)
+	for _, typ := range []*Type{t.keyIn, t.valueIn, t.keyOut, t.valueOut, t.namedKey, t.namedValue} {
+		if typ == nil {
+			continue
+		}
+		typ.walk(func(typ *Type) {
+			if !seen[typ.name] {
+				seen[typ.name] = true
+				res = append(res, typ)
+			}
+		})
+	}
+	return res
+}
+
+// KeyTypes returns the distinct key types of the Target, including the
+// types of slice elements and struct fields.
+func (t *Target) KeyTypes() []*Type {
+	var res []*Type
+	seen := make(map[string]bool)
+	for _, typ := range []*Type{t.keyIn, t.keyOut} {
+		if typ == nil {
+			continue
+		}
+		typ.walk(func(typ *Type) {
+			if !seen[typ.name] {
+				seen[typ.name] = true
+				res = append(res, typ)
+			}
+		})
+	}
+	return res
+}
+
+// Context returns the context interface accepted by the Target's Map or
+// Reduce method, or nil for a Partitioner or grouping comparator.
+func (t *Target) Context() *Interface {
+	return t.ctx
+}
+
+// Counter returns the interface returned by the context's Counter
+// method, or nil if the context has no Counter method.
+func (t *Target) Counter() *Interface {
+	return t.counter
+}
+
+// Setup returns the Target's Setup method, called before the first
+// record of each task, or nil if there is none.
+func (t *Target) Setup() *Method {
+	return t.setup
+}
+
+// Cleanup returns the Target's Cleanup method, called after the last
+// record of each task, or nil if there is none.
+func (t *Target) Cleanup() *Method {
+	return t.cleanup
+}
+
+// Runs returns true if the Target pulls records itself through its Run
+// method, rather than receiving them through Map.
+func (t *Target) Runs() bool {
+	return t.run
+}
+
+// IsRunMethod returns true if the named context method is implemented by
+// the Go bridge for Run, rather than by the generated Java.
+func (t *Target) IsRunMethod(name string) bool {
+	_, ok := runMethods[name]
+	return t.run && ok
+}
+
+// IsBridgeMethod returns true if the named context method is implemented
+// by the Go bridge, rather than by the generated Java.
+func (t *Target) IsBridgeMethod(name string) bool {
+	_, ok := taskMethods[name]
+	return ok || t.IsRunMethod(name)
+}
+
+// CacheFiles returns the URIs of the files the Target declares for the
+// distributed cache.
+func (t *Target) CacheFiles() []string {
+	return t.cacheFiles
+}
+
+// CacheArchives returns the URIs of the archives the Target declares for
+// the distributed cache.
+func (t *Target) CacheArchives() []string {
+	return t.cacheArchives
+}
+
+// NamedOutputs returns the names of the outputs the Target declares for
+// MultipleOutputs.
+func (t *Target) NamedOutputs() []string {
+	return t.namedOutputs
+}
+
+// HasNamedOutput returns true if the Target declares the named output.
+func (t *Target) HasNamedOutput(name string) bool {
+	for _, n := range t.namedOutputs {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// WritesNamed returns true if the context has a WriteNamed method, backed
+// by MultipleOutputs.
+func (t *Target) WritesNamed() bool {
+	return t.namedKey != nil
+}
+
+// WriteNamedReturnsError returns true if the context's WriteNamed method
+// returns an error. Failures are otherwise handled as for Write.
+func (t *Target) WriteNamedReturnsError() bool {
+	return t.namedWriteErrors
+}
+
+// ReturnsError returns true if the Target's Map or Reduce method returns
+// an error.
+func (t *Target) ReturnsError() bool {
+	return t.returnsError
+}
+
+// WriteReturnsError returns true if the context's Write method returns
+// an error. If so, failed writes are returned to the caller. Otherwise,
+// a failed write fails the current Map or Reduce invocation once it
+// returns, and later writes by that invocation are dropped.
+func (t *Target) WriteReturnsError() bool {
+	return t.writeErrors
 }
 
 // IsMapper returns true if this Target is a Mapper.
@@ -107,7 +532,30 @@ func (t *Target) IsMapper() bool {
 	return t.typ == targetMapper
 }
 
-// IsReducer returns true if this Target is a Reducer.
+// IsReducer returns true if this Target is a Reducer, including a
+// Combiner, which Hadoop runs as a Reducer.
 func (t *Target) IsReducer() bool {
-	return t.typ == targetReducer
+	return t.typ == targetReducer || t.typ == targetCombiner
+}
+
+// IsCombiner returns true if this Target is used as the job's Combiner,
+// whether or not it is also its Reducer.
+func (t *Target) IsCombiner() bool {
+	return t.combiner
+}
+
+// Kind returns the kind of this Target, such as "Mapper". A struct that
+// is both a reducer and a combiner is a "Reducer".
+func (t *Target) Kind() string {
+	return t.typ.String()
+}
+
+// IsPartitioner returns true if this Target is a Partitioner.
+func (t *Target) IsPartitioner() bool {
+	return t.typ == targetPartitioner
+}
+
+// IsGrouping returns true if this Target is a grouping comparator.
+func (t *Target) IsGrouping() bool {
+	return t.typ == targetGrouping
 }
@@ -0,0 +1,111 @@
+package mrnative
+
+import (
+	"fmt"
+	"testing"
+)
+
+// runTestSrc declares a target of the annotation substituted for the
+// first %s, with the methods substituted for the second.
+const runTestSrc = `package p
+
+type RunContext interface {
+	Write(key string, val int)
+	Scan() bool
+	Key() int
+	Value() string
+}
+
+type ReducerContext interface {
+	Write(key string, val int)
+	HasNext() bool
+	Next() int
+}
+
+type MapperContext interface {
+	Write(key string, val int)
+}
+
+// %s
+type T struct{}
+
+func NewT() *T {
+	return &T{}
+}
+
+%s
+`
+
+const (
+	runMethod    = "func (t *T) Run(ctx RunContext) error { return nil }"
+	mapMethod    = "func (t *T) Map(key int, val string, ctx MapperContext) {}"
+	reduceMethod = "func (t *T) Reduce(key string, ctx ReducerContext) {}"
+)
+
+func TestNewTargetRun(t *testing.T) {
+	tests := []struct {
+		annotation string
+		typ        targetType
+		methods    string
+		err        string // The expected message, or empty if valid.
+	}{
+		{"@mapper", targetMapper, runMethod, ""},
+		{"@mapper", targetMapper, mapMethod, ""},
+		{"@mapper", targetMapper, mapMethod + "\n" + runMethod, "struct p.T declares both \"Map\" and \"Run\", only one may be declared"},
+		{"@reducer", targetReducer, reduceMethod + "\n" + runMethod, "\"Run\" is only supported on a mapper, a Reducer must declare \"Reduce\""},
+		{"@reducer", targetReducer, runMethod, "\"Run\" is only supported on a mapper, a Reducer must declare \"Reduce\""},
+		{"@combiner", targetCombiner, reduceMethod + "\n" + runMethod, "\"Run\" is only supported on a mapper, a Combiner must declare \"Reduce\""},
+	}
+	for _, tt := range tests {
+		tgt, err := newTestTarget(t, fmt.Sprintf(runTestSrc, tt.annotation, tt.methods), "T", tt.typ)
+		if tt.err == "" {
+			if err != nil {
+				t.Errorf("%s %q: %s", tt.annotation, tt.methods, err)
+			} else if want := tt.methods == runMethod; tgt.run != want {
+				t.Errorf("%s %q: run is %t, want %t", tt.annotation, tt.methods, tgt.run, want)
+			}
+			continue
+		}
+		errs, ok := err.(ErrorList)
+		if !ok || len(errs) != 1 || errs[0].Kind != ErrInvalidSignature || errs[0].Msg != tt.err {
+			t.Errorf("%s %q: got error %v, want %q", tt.annotation, tt.methods, err, tt.err)
+		}
+	}
+}
+
+const annotationTestSrc = `package p
+
+type ReducerContext interface {
+	Write(key string, val int)
+	HasNext() bool
+	Next() int
+}
+
+// Sum adds the counts for a key. Unlike the @combiners of other jobs,
+// it is only run as a reducer.
+//
+// @reducer
+type Sum struct{}
+
+func NewSum() *Sum {
+	return &Sum{}
+}
+
+func (s *Sum) Reduce(key string, ctx ReducerContext) {}
+
+// Helper is used by each @mapper in this package, but is not one.
+type Helper struct{}
+`
+
+func TestLocateTargetsAnnotations(t *testing.T) {
+	g := &Generator{newEnv(), []*Package{loadTestPackage(t, annotationTestSrc)}, []*Target{}}
+	if err := g.locateTargets(); err != nil {
+		t.Fatal(err)
+	}
+	if len(g.targets) != 1 {
+		t.Fatalf("located %d targets, want 1", len(g.targets))
+	}
+	if tgt := g.targets[0]; tgt.decl.name != "Sum" || tgt.Kind() != "Reducer" || tgt.IsCombiner() {
+		t.Errorf("located %s %s, combiner %t, want Reducer Sum, combiner false", tgt.Kind(), tgt.decl.name, tgt.IsCombiner())
+	}
+}
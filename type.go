@@ -1,32 +1,182 @@
 package mrnative
 
-import "strings"
+import (
+	"fmt"
+	"go/types"
+	"strings"
+	"unicode"
+)
 
-var validTypes = []string{
+// scalarTypes are the basic go types with a direct MapReduce equivalent.
+var scalarTypes = []string{
+	"bool",
 	"int",
+	"int8",
 	"int16",
 	"int32",
+	"int64",
+	"uint",
+	"uint8",
+	"uint16",
+	"uint32",
+	"uint64",
+	"byte",
+	"rune",
 	"string",
 	"float32",
 	"float64",
+	"[]byte",
 }
 
+// validTypes are the go types offered when initializing a project.
+var validTypes = append(scalarTypes, sliceOf(scalarTypes)...)
+
+// Java has no unsigned integers, so unsigned go types are mapped to a
+// signed Writable as follows:
+//
+//   - uint16 and uint32 are widened to IntWritable and LongWritable,
+//     which hold every value.
+//   - uint8 and byte are mapped to ByteWritable with the same bits, so
+//     values above 127 are negative in Java and sort before 0.
+//   - uint and uint64 are mapped to LongWritable. Values are checked when
+//     converted; one that does not fit panics with a *bridge.OverflowError.
 var typeMapHadoop = map[string]string{
+	"bool":    "BooleanWritable",
 	"int":     "LongWritable",
+	"int8":    "ByteWritable",
 	"int16":   "ShortWritable",
 	"int32":   "IntWritable",
+	"int64":   "LongWritable",
+	"uint":    "LongWritable",
+	"uint8":   "ByteWritable",
+	"uint16":  "IntWritable",
+	"uint32":  "LongWritable",
+	"uint64":  "LongWritable",
+	"byte":    "ByteWritable",
+	"rune":    "IntWritable",
 	"string":  "Text",
 	"float32": "FloatWritable",
 	"float64": "DoubleWritable",
+	"[]byte":  "BytesWritable",
 }
 
 var typeMapJava = map[string]string{
+	"bool":    "boolean",
 	"int":     "long",
+	"int8":    "byte",
 	"int16":   "short",
 	"int32":   "int",
+	"int64":   "long",
+	"uint":    "long",
+	"uint8":   "byte",
+	"uint16":  "int",
+	"uint32":  "long",
+	"uint64":  "long",
+	"byte":    "byte",
+	"rune":    "int",
 	"string":  "String",
 	"float32": "float",
 	"float64": "double",
+	"[]byte":  "byte[]",
+}
+
+// typeMapBind contains the go types passed to gobind in place of go
+// types gobind does not support.
+var typeMapBind = map[string]string{
+	"uint":   "int64",
+	"uint8":  "int8",
+	"uint16": "int32",
+	"uint32": "int64",
+	"uint64": "int64",
+	"byte":   "int8",
+}
+
+// typeMapToGo contains format strings that convert a value of the bind
+// type to the corresponding go type.
+var typeMapToGo = map[string]string{
+	"uint":   "uint(bridge.ToUint64(%s))",
+	"uint8":  "uint8(%s)",
+	"uint16": "uint16(%s)",
+	"uint32": "uint32(%s)",
+	"uint64": "bridge.ToUint64(%s)",
+	"byte":   "byte(%s)",
+}
+
+// typeMapFromGo contains format strings that convert a value of the
+// corresponding go type to the bind type.
+var typeMapFromGo = map[string]string{
+	"uint":   "bridge.ToInt64(uint64(%s))",
+	"uint8":  "int8(%s)",
+	"uint16": "int32(%s)",
+	"uint32": "int64(%s)",
+	"uint64": "bridge.ToInt64(%s)",
+	"byte":   "int8(%s)",
+}
+
+// typeMapUnwrap contains format strings that extract the Java value
+// from an expression of the corresponding MapReduce type.
+var typeMapUnwrap = map[string]string{
+	"bool":    "%s.get()",
+	"int":     "%s.get()",
+	"int8":    "%s.get()",
+	"int16":   "%s.get()",
+	"int32":   "%s.get()",
+	"int64":   "%s.get()",
+	"uint":    "%s.get()",
+	"uint8":   "%s.get()",
+	"uint16":  "%s.get()",
+	"uint32":  "%s.get()",
+	"uint64":  "%s.get()",
+	"byte":    "%s.get()",
+	"rune":    "%s.get()",
+	"string":  "%s.toString()",
+	"float32": "%s.get()",
+	"float64": "%s.get()",
+	"[]byte":  "MrnativeBridge.bytes(%s)",
+}
+
+// typeMapDecode contains format strings that read a value of the
+// corresponding go type from a bridge.Decoder.
+var typeMapDecode = map[string]string{
+	"bool":    "%s.Bool()",
+	"int":     "int(%s.Int64())",
+	"int8":    "%s.Int8()",
+	"int16":   "%s.Int16()",
+	"int32":   "%s.Int32()",
+	"int64":   "%s.Int64()",
+	"uint":    "uint(bridge.ToUint64(%s.Int64()))",
+	"uint8":   "uint8(%s.Int8())",
+	"uint16":  "uint16(%s.Int32())",
+	"uint32":  "uint32(%s.Int64())",
+	"uint64":  "bridge.ToUint64(%s.Int64())",
+	"byte":    "byte(%s.Int8())",
+	"rune":    "%s.Int32()",
+	"string":  "%s.Text()",
+	"float32": "%s.Float32()",
+	"float64": "%s.Float64()",
+	"[]byte":  "%s.Blob()",
+}
+
+// typeMapEncode contains format strings that write a value of the
+// corresponding go type to a bridge.Encoder.
+var typeMapEncode = map[string]string{
+	"bool":    "%s.Bool(%s)",
+	"int":     "%s.Int64(int64(%s))",
+	"int8":    "%s.Int8(%s)",
+	"int16":   "%s.Int16(%s)",
+	"int32":   "%s.Int32(%s)",
+	"int64":   "%s.Int64(%s)",
+	"uint":    "%s.Int64(bridge.ToInt64(uint64(%s)))",
+	"uint8":   "%s.Int8(int8(%s))",
+	"uint16":  "%s.Int32(int32(%s))",
+	"uint32":  "%s.Int64(int64(%s))",
+	"uint64":  "%s.Int64(bridge.ToInt64(%s))",
+	"byte":    "%s.Int8(int8(%s))",
+	"rune":    "%s.Int32(%s)",
+	"string":  "%s.Text(%s)",
+	"float32": "%s.Float32(%s)",
+	"float64": "%s.Float64(%s)",
+	"[]byte":  "%s.Blob(%s)",
 }
 
 // GoToJavaType converts the given go type into its
@@ -47,10 +197,384 @@ func GoToHadoopType(gt string) string {
 	return ""
 }
 
-func GoToValueInHadoopType(gt string) string {
-	res := GoToHadoopType(gt)
-	if strings.HasPrefix(gt, "[]") {
-		return "Iterable<" + res + ">"
+// HadoopToJavaValue returns an expression that extracts the Java value
+// corresponding to the given go type from expr, an expression of the
+// MapReduce type.
+func HadoopToJavaValue(gt, expr string) string {
+	if m, ok := typeMapUnwrap[gt]; ok {
+		return fmt.Sprintf(m, expr)
+	}
+	return ""
+}
+
+// sliceOf returns the slice type of each of the given types.
+func sliceOf(gts []string) []string {
+	var res []string
+	for _, gt := range gts {
+		res = append(res, "[]"+gt)
+	}
+	return res
+}
+
+// A Type describes how values of a go type are passed between Hadoop
+// and Go.
+//
+// Scalar values are passed to gobind directly. Other values are passed as
+// a byte slice containing the value's Writable serialization. Named types
+// are passed as their underlying type.
+type Type struct {
+	name   string           // The type as it is spelled in the declaring package.
+	scalar string           // The underlying scalar type name, if this is a scalar.
+	elem   *Type            // The element type, if this is a slice.
+	fields []*Field         // The fields, if this is a struct.
+	pkgs   []*types.Package // The packages declaring the type, or its elements.
+}
+
+// A Field is a field of a struct Type.
+type Field struct {
+	name string
+	typ  *Type
+}
+
+// Name returns the go name of the Field.
+func (f *Field) Name() string {
+	return f.name
+}
+
+// JavaName returns the name of the Field in the generated Java class.
+func (f *Field) JavaName() string {
+	if javaKeywords[f.name] {
+		return f.name + "_"
+	}
+	return f.name
+}
+
+// Type returns the type of the Field.
+func (f *Field) Type() *Type {
+	return f.typ
+}
+
+// javaKeywords are the reserved words of Java that are not also reserved
+// in go, and so may be used as field names.
+var javaKeywords = map[string]bool{
+	"abstract": true, "assert": true, "boolean": true, "byte": true,
+	"catch": true, "char": true, "class": true, "do": true, "double": true,
+	"enum": true, "extends": true, "final": true, "finally": true,
+	"float": true, "implements": true, "instanceof": true, "int": true,
+	"long": true, "native": true, "new": true, "null": true, "private": true,
+	"protected": true, "public": true, "short": true, "static": true,
+	"strictfp": true, "super": true, "synchronized": true, "this": true,
+	"throw": true, "throws": true, "transient": true, "true": true,
+	"false": true, "try": true, "void": true, "volatile": true, "while": true,
+}
+
+// NewType returns the Type describing t, or an error if t has no
+// MapReduce equivalent.
+func NewType(t types.Type, qf types.Qualifier) (*Type, error) {
+	name := types.TypeString(t, qf)
+	var pkgs []*types.Package
+	if n, ok := t.(interface{ Obj() *types.TypeName }); ok && n.Obj().Pkg() != nil {
+		pkgs = append(pkgs, n.Obj().Pkg())
+	}
+	if isBytes(t.Underlying()) {
+		return &Type{name: name, scalar: "[]byte", pkgs: pkgs}, nil
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		if _, ok := typeMapHadoop[u.Name()]; ok {
+			return &Type{name: name, scalar: u.Name(), pkgs: pkgs}, nil
+		}
+	case *types.Slice:
+		elem, err := NewType(u.Elem(), qf)
+		if err == nil && elem.IsScalar() {
+			return &Type{name: name, elem: elem, pkgs: append(pkgs, elem.pkgs...)}, nil
+		}
+	case *types.Struct:
+		if len(pkgs) == 0 {
+			return nil, fmt.Errorf("type %s must be a named struct to have a Hadoop equivalent", name)
+		}
+		typ := &Type{name: name, pkgs: pkgs}
+		for i := 0; i < u.NumFields(); i++ {
+			f := u.Field(i)
+			if !f.Exported() && qf(f.Pkg()) != "" {
+				return nil, fmt.Errorf("field %s.%s is not exported", name, f.Name())
+			}
+			ft, err := NewType(f.Type(), qf)
+			if err != nil {
+				return nil, fmt.Errorf("field %s.%s: %v", name, f.Name(), err)
+			}
+			typ.fields = append(typ.fields, &Field{f.Name(), ft})
+			typ.pkgs = append(typ.pkgs, ft.pkgs...)
+		}
+		return typ, nil
+	}
+	return nil, fmt.Errorf("type %s has no Hadoop equivalent", name)
+}
+
+// Name returns the type as it is spelled in the declaring package.
+func (t *Type) Name() string {
+	return t.name
+}
+
+// IsScalar returns true if values of this type are passed directly.
+func (t *Type) IsScalar() bool {
+	return t.scalar != ""
+}
+
+// IsStruct returns true if this is a struct type.
+func (t *Type) IsStruct() bool {
+	return t.scalar == "" && t.elem == nil
+}
+
+// Fields returns the fields of a struct type, in declaration order.
+func (t *Type) Fields() []*Field {
+	return t.fields
+}
+
+// walk calls fn for this type and each type it contains.
+func (t *Type) walk(fn func(*Type)) {
+	fn(t)
+	if t.elem != nil {
+		t.elem.walk(fn)
+	}
+	for _, f := range t.fields {
+		f.typ.walk(fn)
+	}
+}
+
+// IsNamed returns true if the type is a named type, rather than the
+// scalar or slice type it is mapped through.
+func (t *Type) IsNamed() bool {
+	if t.IsStruct() {
+		return true
+	}
+	if t.elem != nil {
+		return t.name != "[]"+t.elem.name
+	}
+	return t.name != t.scalar
+}
+
+// IsSlice returns true if this is a slice type.
+func (t *Type) IsSlice() bool {
+	return t.elem != nil
+}
+
+// Ident returns an identifier unique to this type, suitable for use in
+// generated function names. The identifiers of named types end in
+// "Type", so a named type never shares one with a slice, whose
+// identifier ends in "Slice", nor with a slice of named types.
+func (t *Type) Ident() string {
+	if t.IsNamed() {
+		return identOf(t.name) + "Type"
+	}
+	if t.elem != nil {
+		return t.elem.Ident() + "Slice"
+	}
+	if t.scalar == "[]byte" {
+		return "Bytes"
+	}
+	return strings.ToTitle(t.scalar[:1]) + t.scalar[1:]
+}
+
+// Hadoop returns the MapReduce type.
+func (t *Type) Hadoop() string {
+	if t.IsStruct() {
+		return identOf(t.name) + "Writable"
+	}
+	if t.elem != nil {
+		return strings.TrimSuffix(t.elem.Hadoop(), "Writable") + "ArrayWritable"
+	}
+	return typeMapHadoop[t.scalar]
+}
+
+// Java returns the Java type passed to and from gobind.
+func (t *Type) Java() string {
+	if t.IsScalar() {
+		return typeMapJava[t.scalar]
+	}
+	return "byte[]"
+}
+
+// Bind returns the go type passed to and from gobind.
+func (t *Type) Bind() string {
+	if b, ok := typeMapBind[t.scalar]; ok {
+		return b
+	}
+	if t.IsScalar() {
+		return t.scalar
+	}
+	return "[]byte"
+}
+
+// Unwrap returns a Java expression converting expr, of the MapReduce
+// type, to the Java type.
+func (t *Type) Unwrap(expr string) string {
+	if t.IsScalar() {
+		return HadoopToJavaValue(t.scalar, expr)
+	}
+	return fmt.Sprintf("MrnativeBridge.toBytes(%s)", expr)
+}
+
+// Wrap returns a Java expression converting expr, of the Java type, to
+// the MapReduce type.
+func (t *Type) Wrap(expr string) string {
+	if t.IsScalar() {
+		return fmt.Sprintf("new %s(%s)", t.Hadoop(), expr)
+	}
+	return fmt.Sprintf("MrnativeBridge.fromBytes(%s, new %s())", expr, t.Hadoop())
+}
+
+// RawCompare returns a Java expression comparing the serialized values of
+// this type starting at b1[s1] and b2[s2], without deserializing them.
+func (t *Type) RawCompare(b1, s1, b2, s2 string) string {
+	return fmt.Sprintf("%s(%s, %s, %s, %s)", t.rawMethod("compare"), b1, s1, b2, s2)
+}
+
+// RawLength returns a Java expression computing the length of the
+// serialized value of this type starting at b[s].
+func (t *Type) RawLength(b, s string) string {
+	return fmt.Sprintf("%s(%s, %s)", t.rawMethod("length"), b, s)
+}
+
+// rawMethod returns the Java method implementing the given raw operation.
+// Those of generated classes are static methods of the class; others are
+// provided by MrnativeBridge.
+func (t *Type) rawMethod(op string) string {
+	if t.IsScalar() {
+		return "MrnativeBridge." + op + t.Hadoop()
+	}
+	return t.Hadoop() + "." + op + "Raw"
+}
+
+// Params returns the go parameter declarations for a value of this type
+// named name, as passed from Java.
+//
+// A []byte is passed as the backing array of its BytesWritable along
+// with the array's valid length, avoiding a copy on the Java side.
+func (t *Type) Params(name string) string {
+	if t.scalar == "[]byte" {
+		return fmt.Sprintf("%s []byte, %sLen int", name, name)
+	}
+	return name + " " + t.Bind()
+}
+
+// Args returns the Java arguments passing expr, of the MapReduce type,
+// to parameters declared by Params.
+func (t *Type) Args(expr string) string {
+	if t.scalar == "[]byte" {
+		return fmt.Sprintf("%s.getBytes(), %s.getLength()", expr, expr)
+	}
+	return t.Unwrap(expr)
+}
+
+// ToGoParams returns a go expression converting the parameters declared
+// by Params to this type.
+func (t *Type) ToGoParams(name string) string {
+	if t.scalar == "[]byte" {
+		return t.named(fmt.Sprintf("%s[:%sLen]", name, name))
+	}
+	return t.ToGo(name)
+}
+
+// ToGo returns a go expression converting expr, of the bind type, to
+// this type.
+func (t *Type) ToGo(expr string) string {
+	if f, ok := typeMapToGo[t.scalar]; ok {
+		return t.named(fmt.Sprintf(f, expr))
+	}
+	if t.IsScalar() {
+		return t.named(expr)
+	}
+	return fmt.Sprintf("decode%s(%s)", t.Ident(), expr)
+}
+
+// FromGo returns a go expression converting expr, of this type, to the
+// bind type.
+func (t *Type) FromGo(expr string) string {
+	if t.IsScalar() && t.IsNamed() {
+		expr = t.scalar + "(" + expr + ")"
+	}
+	if f, ok := typeMapFromGo[t.scalar]; ok {
+		return fmt.Sprintf(f, expr)
+	}
+	if t.IsScalar() {
+		return expr
+	}
+	return fmt.Sprintf("encode%s(%s)", t.Ident(), expr)
+}
+
+// Decode returns a go expression reading a value of this type from the
+// bridge.Decoder d.
+func (t *Type) Decode(d string) string {
+	if t.IsStruct() {
+		return fmt.Sprintf("read%s(%s)", t.Ident(), d)
+	}
+	if t.elem != nil {
+		return fmt.Sprintf("bridge.DecodeSlice(%s, func(d *bridge.Decoder) %s { return %s })", d, t.elem.name, t.elem.Decode("d"))
+	}
+	return t.named(fmt.Sprintf(typeMapDecode[t.scalar], d))
+}
+
+// Encode returns a go statement writing expr, a value of this type, to
+// the bridge.Encoder e.
+func (t *Type) Encode(e, expr string) string {
+	if t.IsStruct() {
+		return fmt.Sprintf("write%s(%s, %s)", t.Ident(), e, expr)
+	}
+	if t.elem != nil {
+		return fmt.Sprintf("bridge.EncodeSlice(%s, %s, func(e *bridge.Encoder, v %s) { %s })", e, expr, t.elem.name, t.elem.Encode("e", "v"))
+	}
+	if t.IsNamed() {
+		expr = t.scalar + "(" + expr + ")"
+	}
+	return fmt.Sprintf(typeMapEncode[t.scalar], e, expr)
+}
+
+// named returns a go expression converting expr, of the underlying
+// scalar type, to this type.
+func (t *Type) named(expr string) string {
+	if !t.IsNamed() {
+		return expr
+	}
+	return t.name + "(" + expr + ")"
+}
+
+// Imports returns the import paths of the packages declaring this type
+// or its elements, other than pkg.
+func (t *Type) Imports(pkg *types.Package) []string {
+	var res []string
+	for _, p := range t.pkgs {
+		if p != pkg {
+			res = append(res, p.Path())
+		}
 	}
 	return res
 }
+
+// identOf returns an exported identifier derived from the go type name.
+func identOf(name string) string {
+	var res []rune
+	upper := true
+	for _, r := range name {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if upper {
+				r = unicode.ToUpper(r)
+			}
+			res = append(res, r)
+			upper = false
+		default:
+			upper = true
+		}
+	}
+	return string(res)
+}
+
+// isBytes returns true if t is a byte slice.
+func isBytes(t types.Type) bool {
+	if s, ok := t.(*types.Slice); ok {
+		b, ok := s.Elem().(*types.Basic)
+		return ok && b.Kind() == types.Byte
+	}
+	return false
+}
@@ -0,0 +1,236 @@
+package mrnative
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+const typeTestSrc = `package p
+
+type Celsius float64
+
+type Tags []string
+
+type Point struct {
+	X, Y int32
+	Name string
+}
+
+type Bad struct {
+	M map[string]int
+}
+
+var (
+	vBool    bool
+	vInt     int
+	vUint    uint
+	vUint16  uint16
+	vRune    rune
+	vString  string
+	vBytes   []byte
+	vCelsius Celsius
+	vInts    []int
+	vTags    Tags
+	vPoint   Point
+
+	vMap     map[string]int
+	vComplex complex64
+	vNested  [][]int
+	vAnon    struct{ X int }
+	vBad     Bad
+)
+
+type (
+	Int64Slice   []int64
+	CelsiusSlice []float64
+	Bytes        []byte
+)
+
+var (
+	vInt64s       []int64
+	vInt64Slice   Int64Slice
+	vCelsiuses    []Celsius
+	vCelsiusSlice CelsiusSlice
+	vNamedBytes   Bytes
+)
+`
+
+// checkTypeTestSrc type checks typeTestSrc, returning its package.
+func checkTypeTestSrc(t *testing.T) *types.Package {
+	t.Helper()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "p.go", typeTestSrc, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := (&types.Config{}).Check("p", fset, []*ast.File{f}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pkg
+}
+
+var typeTests = []struct {
+	v string // The variable in typeTestSrc whose type is tested.
+
+	name, hadoop, java, bind string
+	toGo, fromGo             string
+	decode, encode           string
+}{
+	{
+		"vBool",
+		"bool", "BooleanWritable", "boolean", "bool",
+		"x", "x",
+		"d.Bool()", "e.Bool(x)",
+	},
+	{
+		"vInt",
+		"int", "LongWritable", "long", "int",
+		"x", "x",
+		"int(d.Int64())", "e.Int64(int64(x))",
+	},
+	{
+		"vUint",
+		"uint", "LongWritable", "long", "int64",
+		"uint(bridge.ToUint64(x))", "bridge.ToInt64(uint64(x))",
+		"uint(bridge.ToUint64(d.Int64()))", "e.Int64(bridge.ToInt64(uint64(x)))",
+	},
+	{
+		"vUint16",
+		"uint16", "IntWritable", "int", "int32",
+		"uint16(x)", "int32(x)",
+		"uint16(d.Int32())", "e.Int32(int32(x))",
+	},
+	{
+		"vRune",
+		"rune", "IntWritable", "int", "rune",
+		"x", "x",
+		"d.Int32()", "e.Int32(x)",
+	},
+	{
+		"vString",
+		"string", "Text", "String", "string",
+		"x", "x",
+		"d.Text()", "e.Text(x)",
+	},
+	{
+		"vBytes",
+		"[]byte", "BytesWritable", "byte[]", "[]byte",
+		"x", "x",
+		"d.Blob()", "e.Blob(x)",
+	},
+	{
+		"vCelsius",
+		"Celsius", "DoubleWritable", "double", "float64",
+		"Celsius(x)", "float64(x)",
+		"Celsius(d.Float64())", "e.Float64(float64(x))",
+	},
+	{
+		"vInts",
+		"[]int", "LongArrayWritable", "byte[]", "[]byte",
+		"decodeIntSlice(x)", "encodeIntSlice(x)",
+		"bridge.DecodeSlice(d, func(d *bridge.Decoder) int { return int(d.Int64()) })",
+		"bridge.EncodeSlice(e, x, func(e *bridge.Encoder, v int) { e.Int64(int64(v)) })",
+	},
+	{
+		"vTags",
+		"Tags", "TextArrayWritable", "byte[]", "[]byte",
+		"decodeTagsType(x)", "encodeTagsType(x)",
+		"bridge.DecodeSlice(d, func(d *bridge.Decoder) string { return d.Text() })",
+		"bridge.EncodeSlice(e, x, func(e *bridge.Encoder, v string) { e.Text(v) })",
+	},
+	{
+		"vPoint",
+		"Point", "PointWritable", "byte[]", "[]byte",
+		"decodePointType(x)", "encodePointType(x)",
+		"readPointType(d)", "writePointType(e, x)",
+	},
+}
+
+func TestNewType(t *testing.T) {
+	pkg := checkTypeTestSrc(t)
+	qf := types.RelativeTo(pkg)
+	for _, tt := range typeTests {
+		typ, err := NewType(pkg.Scope().Lookup(tt.v).Type(), qf)
+		if err != nil {
+			t.Errorf("%s: %s", tt.v, err)
+			continue
+		}
+		got := []string{
+			typ.Name(), typ.Hadoop(), typ.Java(), typ.Bind(),
+			typ.ToGo("x"), typ.FromGo("x"),
+			typ.Decode("d"), typ.Encode("e", "x"),
+		}
+		want := []string{
+			tt.name, tt.hadoop, tt.java, tt.bind,
+			tt.toGo, tt.fromGo,
+			tt.decode, tt.encode,
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Errorf("%s: got %q, want %q", tt.v, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestNewTypeStructFields(t *testing.T) {
+	pkg := checkTypeTestSrc(t)
+	typ, err := NewType(pkg.Scope().Lookup("vPoint").Type(), types.RelativeTo(pkg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !typ.IsStruct() || !typ.IsNamed() {
+		t.Errorf("Point: IsStruct %t, IsNamed %t, want both", typ.IsStruct(), typ.IsNamed())
+	}
+	var got []string
+	for _, f := range typ.Fields() {
+		got = append(got, f.Name()+" "+f.Type().Hadoop())
+	}
+	want := "X IntWritable, Y IntWritable, Name Text"
+	if strings.Join(got, ", ") != want {
+		t.Errorf("Point fields: got %q, want %q", strings.Join(got, ", "), want)
+	}
+}
+
+func TestTypeIdentUnique(t *testing.T) {
+	pkg := checkTypeTestSrc(t)
+	qf := types.RelativeTo(pkg)
+	seen := make(map[string]string)
+	for _, v := range []string{"vBytes", "vNamedBytes", "vInt64s", "vInt64Slice", "vCelsius", "vCelsiuses", "vCelsiusSlice"} {
+		typ, err := NewType(pkg.Scope().Lookup(v).Type(), qf)
+		if err != nil {
+			t.Fatalf("%s: %s", v, err)
+		}
+		if prev, ok := seen[typ.Ident()]; ok {
+			t.Errorf("%s and %s share the identifier %s", prev, typ.Name(), typ.Ident())
+		}
+		seen[typ.Ident()] = typ.Name()
+	}
+}
+
+var typeErrorTests = []struct {
+	v   string
+	err string
+}{
+	{"vMap", "type map[string]int has no Hadoop equivalent"},
+	{"vComplex", "type complex64 has no Hadoop equivalent"},
+	{"vNested", "type [][]int has no Hadoop equivalent"},
+	{"vAnon", "type struct{X int} must be a named struct to have a Hadoop equivalent"},
+	{"vBad", "field Bad.M: type map[string]int has no Hadoop equivalent"},
+}
+
+func TestNewTypeErrors(t *testing.T) {
+	pkg := checkTypeTestSrc(t)
+	qf := types.RelativeTo(pkg)
+	for _, tt := range typeErrorTests {
+		_, err := NewType(pkg.Scope().Lookup(tt.v).Type(), qf)
+		if err == nil || err.Error() != tt.err {
+			t.Errorf("%s: got error %v, want %q", tt.v, err, tt.err)
+		}
+	}
+}